@@ -9,10 +9,10 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/github/github-mcp-server/pkg/github"
-	"github.com/github/github-mcp-server/pkg/raw"
-	"github.com/github/github-mcp-server/pkg/toolsets"
-	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-http/pkg/github"
+	"github.com/github/github-mcp-http/pkg/raw"
+	"github.com/github/github-mcp-http/pkg/toolsets"
+	"github.com/github/github-mcp-http/pkg/translations"
 	gogithub "github.com/google/go-github/v74/github"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/shurcooL/githubv4"
@@ -143,7 +143,11 @@ func generateToolsetsDoc(tsg *toolsets.ToolsetGroup) string {
 
 	for _, name := range toolsetNames {
 		toolset := tsg.Toolsets[name]
-		lines = append(lines, fmt.Sprintf("| `%s` | %s |", name, toolset.Description))
+		description := toolset.Description
+		if toolset.OptIn {
+			description += " _(opt-in)_"
+		}
+		lines = append(lines, fmt.Sprintf("| `%s` | %s |", name, description))
 	}
 
 	return strings.Join(lines, "\n")
@@ -6,8 +6,8 @@ import (
 	"strings"
 	"time"
 
-	"github.com/github/github-mcp-server/internal/ghmcp"
-	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-http/internal/ghmcp"
+	"github.com/github/github-mcp-http/pkg/github"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -40,15 +40,19 @@ var (
 		Short: "Start HTTP server",
 		Long:  `Start a server that communicates over HTTP using the streamable transport.`,
 		RunE: func(_ *cobra.Command, _ []string) error {
-			var enabledToolsets []string
-			if err := viper.UnmarshalKey("toolsets", &enabledToolsets); err != nil {
-				return fmt.Errorf("failed to unmarshal toolsets: %w", err)
+			reloadable, err := loadConfig()
+			if err != nil {
+				return err
+			}
+			// Values from the config file are validated when it is loaded; flags are checked here.
+			if contentWindowSize := viper.GetInt("content-window-size"); contentWindowSize <= 0 {
+				return fmt.Errorf("content-window-size: must be greater than zero, got %d", contentWindowSize)
 			}
 
 			httpServerConfig := ghmcp.HTTPServerConfig{
 				Version:           version,
 				Host:              viper.GetString("host"),
-				EnabledToolsets:   enabledToolsets,
+				EnabledToolsets:   reloadable.EnabledToolsets,
 				DynamicToolsets:   viper.GetBool("dynamic_toolsets"),
 				ReadOnly:          viper.GetBool("read-only"),
 				ContentWindowSize: viper.GetInt("content-window-size"),
@@ -57,6 +61,19 @@ var (
 				HealthPath:        viper.GetString("health-path"),
 				ShutdownTimeout:   viper.GetDuration("shutdown-timeout"),
 				LogFilePath:       viper.GetString("log-file"),
+				LogFormat:         viper.GetString("log-format"),
+				LogLevel:          reloadable.LogLevel,
+				MetricsPath:       viper.GetString("metrics-path"),
+				IncludeErrorMeta:  viper.GetBool("include-error-meta"),
+				ToolOverrides:     reloadable.ToolOverrides,
+				Limits: ghmcp.LimitConfig{
+					MaxConcurrent:     viper.GetInt("max-concurrent-requests"),
+					RequestsPerMinute: viper.GetInt("requests-per-minute"),
+					DailyBudget:       viper.GetInt("daily-request-budget"),
+				},
+			}
+			if viper.GetString("config") != "" {
+				httpServerConfig.Reload = loadConfig
 			}
 			return ghmcp.RunHTTPServer(httpServerConfig)
 		},
@@ -70,20 +87,26 @@ func init() {
 	rootCmd.SetVersionTemplate("{{.Short}}\n{{.Version}}\n")
 
 	// Add global flags that will be shared by all commands
+	rootCmd.PersistentFlags().String("config", "", "Path to a YAML, TOML or JSON configuration file")
 	rootCmd.PersistentFlags().StringSlice("toolsets", github.DefaultTools, "An optional comma separated list of groups of tools to allow, defaults to enabling all")
 	rootCmd.PersistentFlags().Bool("dynamic-toolsets", false, "Enable dynamic toolsets")
 	rootCmd.PersistentFlags().Bool("read-only", false, "Restrict the server to read-only operations")
 	rootCmd.PersistentFlags().String("log-file", "", "Path to log file")
+	rootCmd.PersistentFlags().String("log-format", "text", "Log output format: text or json")
+	rootCmd.PersistentFlags().String("log-level", "", "Log level: debug, info, warn or error (defaults to debug with --log-file, info otherwise)")
 	rootCmd.PersistentFlags().Bool("enable-command-logging", false, "When enabled, the server will log all command requests and responses to the log file")
 	rootCmd.PersistentFlags().Bool("export-translations", false, "Save translations to a JSON file")
 	rootCmd.PersistentFlags().String("gh-host", "", "Specify the GitHub hostname (for GitHub Enterprise etc.)")
 	rootCmd.PersistentFlags().Int("content-window-size", 5000, "Specify the content window size")
 
 	// Bind flag to viper
+	_ = viper.BindPFlag("config", rootCmd.PersistentFlags().Lookup("config"))
 	_ = viper.BindPFlag("toolsets", rootCmd.PersistentFlags().Lookup("toolsets"))
 	_ = viper.BindPFlag("dynamic_toolsets", rootCmd.PersistentFlags().Lookup("dynamic-toolsets"))
 	_ = viper.BindPFlag("read-only", rootCmd.PersistentFlags().Lookup("read-only"))
 	_ = viper.BindPFlag("log-file", rootCmd.PersistentFlags().Lookup("log-file"))
+	_ = viper.BindPFlag("log-format", rootCmd.PersistentFlags().Lookup("log-format"))
+	_ = viper.BindPFlag("log-level", rootCmd.PersistentFlags().Lookup("log-level"))
 	_ = viper.BindPFlag("enable-command-logging", rootCmd.PersistentFlags().Lookup("enable-command-logging"))
 	_ = viper.BindPFlag("export-translations", rootCmd.PersistentFlags().Lookup("export-translations"))
 	_ = viper.BindPFlag("host", rootCmd.PersistentFlags().Lookup("gh-host"))
@@ -93,11 +116,21 @@ func init() {
 	httpCmd.Flags().String("http-path", "/mcp", "HTTP path for MCP requests")
 	httpCmd.Flags().String("health-path", "/health", "HTTP path for health checks")
 	httpCmd.Flags().Duration("shutdown-timeout", 10*time.Second, "Graceful shutdown timeout for the HTTP server")
+	httpCmd.Flags().String("metrics-path", "", "HTTP path serving the GitHub error counters as JSON, without authentication (disabled when empty)")
+	httpCmd.Flags().Bool("include-error-meta", false, "Add a structured summary of GitHub errors to the _meta of tool results")
+	httpCmd.Flags().Int("max-concurrent-requests", 0, "Maximum number of concurrent GitHub requests per token (0 for unlimited)")
+	httpCmd.Flags().Int("requests-per-minute", 0, "Maximum number of GitHub requests per minute per token (0 for unlimited)")
+	httpCmd.Flags().Int("daily-request-budget", 0, "Maximum number of GitHub requests per UTC day per token (0 for unlimited)")
 
 	_ = viper.BindPFlag("listen-address", httpCmd.Flags().Lookup("listen"))
 	_ = viper.BindPFlag("http-path", httpCmd.Flags().Lookup("http-path"))
 	_ = viper.BindPFlag("health-path", httpCmd.Flags().Lookup("health-path"))
 	_ = viper.BindPFlag("shutdown-timeout", httpCmd.Flags().Lookup("shutdown-timeout"))
+	_ = viper.BindPFlag("metrics-path", httpCmd.Flags().Lookup("metrics-path"))
+	_ = viper.BindPFlag("include-error-meta", httpCmd.Flags().Lookup("include-error-meta"))
+	_ = viper.BindPFlag("max-concurrent-requests", httpCmd.Flags().Lookup("max-concurrent-requests"))
+	_ = viper.BindPFlag("requests-per-minute", httpCmd.Flags().Lookup("requests-per-minute"))
+	_ = viper.BindPFlag("daily-request-budget", httpCmd.Flags().Lookup("daily-request-budget"))
 
 	// Add subcommands
 	rootCmd.AddCommand(stdioCmd)
@@ -108,7 +141,35 @@ func initConfig() {
 	// Initialize Viper configuration
 	viper.SetEnvPrefix("github")
 	viper.AutomaticEnv()
+}
+
+// loadConfig reads and validates the configuration file, if one was given, and returns
+// the settings that can be reloaded at runtime. Flags and environment variables take
+// precedence over values from the file.
+func loadConfig() (ghmcp.ReloadableConfig, error) {
+	var toolOverrides map[string]ghmcp.ToolOverride
+	if path := viper.GetString("config"); path != "" {
+		fileConfig, err := ghmcp.LoadConfigFile(path)
+		if err != nil {
+			return ghmcp.ReloadableConfig{}, err
+		}
+		viper.SetConfigFile(path)
+		if err := viper.ReadInConfig(); err != nil {
+			return ghmcp.ReloadableConfig{}, fmt.Errorf("failed to read config file: %w", err)
+		}
+		toolOverrides = fileConfig.Tools
+	}
+
+	var enabledToolsets []string
+	if err := viper.UnmarshalKey("toolsets", &enabledToolsets); err != nil {
+		return ghmcp.ReloadableConfig{}, fmt.Errorf("failed to unmarshal toolsets: %w", err)
+	}
 
+	return ghmcp.ReloadableConfig{
+		EnabledToolsets: enabledToolsets,
+		ToolOverrides:   toolOverrides,
+		LogLevel:        viper.GetString("log-level"),
+	}, nil
 }
 
 func main() {
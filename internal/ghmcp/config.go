@@ -0,0 +1,202 @@
+package ghmcp
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/github/github-mcp-http/pkg/github"
+	mcplog "github.com/github/github-mcp-http/pkg/log"
+	"github.com/github/github-mcp-http/pkg/translations"
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/spf13/viper"
+)
+
+// FileConfig is the schema of the configuration file passed with --config.
+// Keys mirror the viper keys used by the command line flags, so a value in the
+// file is overridden by the matching flag or GITHUB_* environment variable.
+type FileConfig struct {
+	Toolsets          []string                `mapstructure:"toolsets"`
+	DynamicToolsets   *bool                   `mapstructure:"dynamic_toolsets"`
+	ReadOnly          *bool                   `mapstructure:"read-only"`
+	Host              string                  `mapstructure:"host"`
+	ContentWindowSize *int                    `mapstructure:"content-window-size"`
+	ListenAddress     string                  `mapstructure:"listen-address"`
+	EndpointPath      string                  `mapstructure:"http-path"`
+	HealthPath        string                  `mapstructure:"health-path"`
+	ShutdownTimeout   *time.Duration          `mapstructure:"shutdown-timeout"`
+	LogFile           string                  `mapstructure:"log-file"`
+	LogFormat         string                  `mapstructure:"log-format"`
+	LogLevel          string                  `mapstructure:"log-level"`
+	MetricsPath       string                  `mapstructure:"metrics-path"`
+	IncludeErrorMeta  *bool                   `mapstructure:"include-error-meta"`
+	MaxConcurrent     *int                    `mapstructure:"max-concurrent-requests"`
+	RequestsPerMinute *int                    `mapstructure:"requests-per-minute"`
+	DailyBudget       *int                    `mapstructure:"daily-request-budget"`
+	Tools             map[string]ToolOverride `mapstructure:"tools"`
+}
+
+// ToolOverride customises a single tool. Empty fields keep the built-in value.
+type ToolOverride struct {
+	Description string `mapstructure:"description"`
+	Title       string `mapstructure:"title"`
+	Disabled    bool   `mapstructure:"disabled"`
+}
+
+// ReloadableConfig holds the settings that can be changed on a running server
+// without restarting it.
+type ReloadableConfig struct {
+	EnabledToolsets []string
+	ToolOverrides   map[string]ToolOverride
+	LogLevel        string
+}
+
+// ReloadFunc re-reads the configuration and returns the settings to apply.
+type ReloadFunc func() (ReloadableConfig, error)
+
+var supportedConfigExtensions = []string{".yaml", ".yml", ".toml", ".json"}
+
+// LoadConfigFile reads the configuration file at path and validates it against
+// the FileConfig schema. Unknown keys, wrongly typed values and unknown
+// toolsets or tools are reported as errors.
+func LoadConfigFile(path string) (*FileConfig, error) {
+	ext := strings.ToLower(filepath.Ext(path))
+	if !containsString(supportedConfigExtensions, ext) {
+		return nil, fmt.Errorf("config file %s: unsupported extension %q (expected one of %s)", path, ext, strings.Join(supportedConfigExtensions, ", "))
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("config file %s: %w", path, err)
+	}
+
+	var cfg FileConfig
+	var metadata mapstructure.Metadata
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.StringToTimeDurationHookFunc(),
+		Metadata:   &metadata,
+		Result:     &cfg,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create config decoder: %w", err)
+	}
+	if err := decoder.Decode(v.AllSettings()); err != nil {
+		return nil, fmt.Errorf("config file %s: %w", path, err)
+	}
+	if len(metadata.Unused) > 0 {
+		sort.Strings(metadata.Unused)
+		return nil, fmt.Errorf("config file %s: unknown keys: %s", path, strings.Join(metadata.Unused, ", "))
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("config file %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// Validate checks the values of the configuration for consistency.
+func (c *FileConfig) Validate() error {
+	toolsetNames, toolNames := knownToolsetsAndTools()
+
+	for i, name := range c.Toolsets {
+		if name != "all" && !toolsetNames[name] {
+			return fmt.Errorf("toolsets[%d]: unknown toolset %q", i, name)
+		}
+	}
+	if c.Host != "" {
+		if _, err := parseAPIHost(c.Host); err != nil {
+			return fmt.Errorf("host: %w", err)
+		}
+	}
+	if c.ContentWindowSize != nil && *c.ContentWindowSize <= 0 {
+		return fmt.Errorf("content-window-size: must be greater than zero, got %d", *c.ContentWindowSize)
+	}
+	if c.ListenAddress != "" {
+		if _, _, err := net.SplitHostPort(c.ListenAddress); err != nil {
+			return fmt.Errorf("listen-address: %w", err)
+		}
+	}
+	if c.EndpointPath != "" && c.HealthPath != "" && normalizePath(c.EndpointPath, "") == normalizePath(c.HealthPath, "") {
+		return fmt.Errorf("http-path and health-path must differ, both are %q", c.EndpointPath)
+	}
+	if c.ShutdownTimeout != nil && *c.ShutdownTimeout <= 0 {
+		return fmt.Errorf("shutdown-timeout: must be greater than zero, got %s", *c.ShutdownTimeout)
+	}
+	if c.LogFormat != "" && c.LogFormat != mcplog.FormatText && c.LogFormat != mcplog.FormatJSON {
+		return fmt.Errorf("log-format: must be %q or %q, got %q", mcplog.FormatText, mcplog.FormatJSON, c.LogFormat)
+	}
+	if c.LogLevel != "" {
+		if _, err := mcplog.ParseLevel(c.LogLevel); err != nil {
+			return fmt.Errorf("log-level: %w", err)
+		}
+	}
+	for key, value := range map[string]*int{
+		"max-concurrent-requests": c.MaxConcurrent,
+		"requests-per-minute":     c.RequestsPerMinute,
+		"daily-request-budget":    c.DailyBudget,
+	} {
+		if value != nil && *value < 0 {
+			return fmt.Errorf("%s: must not be negative, got %d", key, *value)
+		}
+	}
+	for name := range c.Tools {
+		if !toolNames[name] {
+			return fmt.Errorf("tools.%s: unknown tool", name)
+		}
+	}
+	return nil
+}
+
+// knownToolsetsAndTools returns the names of every toolset and tool the server can offer.
+func knownToolsetsAndTools() (toolsets map[string]bool, tools map[string]bool) {
+	tsg := github.DefaultToolsetGroup(false, nil, nil, nil, translations.NullTranslationHelper, 0)
+	toolsets = make(map[string]bool, len(tsg.Toolsets))
+	tools = make(map[string]bool)
+	for name, toolset := range tsg.Toolsets {
+		toolsets[name] = true
+		for _, tool := range toolset.GetAvailableTools() {
+			tools[tool.Tool.Name] = true
+		}
+	}
+	return toolsets, tools
+}
+
+// toolOverrideTranslations converts tool overrides into translation key overrides.
+func toolOverrideTranslations(overrides map[string]ToolOverride) map[string]string {
+	keys := make(map[string]string)
+	for name, override := range overrides {
+		prefix := "TOOL_" + strings.ToUpper(name)
+		if override.Description != "" {
+			keys[prefix+"_DESCRIPTION"] = override.Description
+		}
+		if override.Title != "" {
+			keys[prefix+"_USER_TITLE"] = override.Title
+		}
+	}
+	return keys
+}
+
+// disabledTools returns the names of the tools the overrides switch off.
+func disabledTools(overrides map[string]ToolOverride) []string {
+	var names []string
+	for name, override := range overrides {
+		if override.Disabled {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+package ghmcp
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-http/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfigFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	t.Run("valid yaml", func(t *testing.T) {
+		path := writeConfigFile(t, "config.yaml", `
+toolsets: [repos, issues]
+read-only: true
+host: https://github.example.com
+listen-address: ":9090"
+http-path: /rpc
+shutdown-timeout: 30s
+content-window-size: 1000
+requests-per-minute: 120
+tools:
+  get_issue:
+    description: Fetch an issue
+  create_issue:
+    disabled: true
+`)
+		cfg, err := LoadConfigFile(path)
+		require.NoError(t, err)
+		require.Equal(t, []string{"repos", "issues"}, cfg.Toolsets)
+		require.True(t, *cfg.ReadOnly)
+		require.Equal(t, ":9090", cfg.ListenAddress)
+		require.Equal(t, 30*time.Second, *cfg.ShutdownTimeout)
+		require.Equal(t, 1000, *cfg.ContentWindowSize)
+		require.Equal(t, 120, *cfg.RequestsPerMinute)
+		require.Equal(t, "Fetch an issue", cfg.Tools["get_issue"].Description)
+		require.True(t, cfg.Tools["create_issue"].Disabled)
+	})
+
+	t.Run("valid toml", func(t *testing.T) {
+		path := writeConfigFile(t, "config.toml", `
+toolsets = ["all"]
+dynamic_toolsets = true
+
+[tools.list_issues]
+title = "Issues"
+`)
+		cfg, err := LoadConfigFile(path)
+		require.NoError(t, err)
+		require.Equal(t, []string{"all"}, cfg.Toolsets)
+		require.True(t, *cfg.DynamicToolsets)
+		require.Equal(t, "Issues", cfg.Tools["list_issues"].Title)
+	})
+
+	errorCases := []struct {
+		name        string
+		file        string
+		content     string
+		expectedErr string
+	}{
+		{
+			name:        "unsupported extension",
+			file:        "config.ini",
+			content:     "toolsets=repos",
+			expectedErr: "unsupported extension",
+		},
+		{
+			name:        "negative limit",
+			file:        "config.yaml",
+			content:     "daily-request-budget: -1\n",
+			expectedErr: "daily-request-budget: must not be negative",
+		},
+		{
+			name:        "unknown key",
+			file:        "config.yaml",
+			content:     "toolset: [repos]\n",
+			expectedErr: "unknown keys: toolset",
+		},
+		{
+			name:        "unknown toolset",
+			file:        "config.yaml",
+			content:     "toolsets: [repos, nope]\n",
+			expectedErr: `toolsets[1]: unknown toolset "nope"`,
+		},
+		{
+			name:        "invalid duration",
+			file:        "config.yaml",
+			content:     "shutdown-timeout: soon\n",
+			expectedErr: "shutdown-timeout",
+		},
+		{
+			name:        "non-positive content window",
+			file:        "config.yaml",
+			content:     "content-window-size: 0\n",
+			expectedErr: "content-window-size: must be greater than zero",
+		},
+		{
+			name:        "invalid listen address",
+			file:        "config.yaml",
+			content:     "listen-address: localhost\n",
+			expectedErr: "listen-address",
+		},
+		{
+			name:        "unknown tool override",
+			file:        "config.yaml",
+			content:     "tools:\n  get_isue:\n    disabled: true\n",
+			expectedErr: "tools.get_isue: unknown tool",
+		},
+	}
+
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := writeConfigFile(t, tc.file, tc.content)
+			_, err := LoadConfigFile(path)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), path)
+			require.Contains(t, err.Error(), tc.expectedErr)
+		})
+	}
+}
+
+func listToolNames(t *testing.T, cfg MCPServerConfig, reload *ReloadableConfig) map[string]string {
+	t.Helper()
+	ghServer, reloader, err := newMCPServer(cfg)
+	require.NoError(t, err)
+	if reload != nil {
+		require.NoError(t, reloader.reload(*reload))
+	}
+
+	response := ghServer.HandleMessage(context.Background(), json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`))
+	data, err := json.Marshal(response)
+	require.NoError(t, err)
+	var decoded struct {
+		Result mcp.ListToolsResult `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+
+	tools := make(map[string]string, len(decoded.Result.Tools))
+	for _, tool := range decoded.Result.Tools {
+		tools[tool.Name] = tool.Description
+	}
+	return tools
+}
+
+func TestToolOverridesAndReload(t *testing.T) {
+	cfg := MCPServerConfig{
+		Version:         "test",
+		Token:           "token",
+		EnabledToolsets: []string{"issues"},
+		Translator:      translations.NullTranslationHelper,
+		ToolOverrides: map[string]ToolOverride{
+			"get_issue":    {Description: "custom description"},
+			"create_issue": {Disabled: true},
+		},
+	}
+
+	tools := listToolNames(t, cfg, nil)
+	require.Equal(t, "custom description", tools["get_issue"])
+	require.NotContains(t, tools, "create_issue")
+	require.NotContains(t, tools, "get_pull_request")
+
+	tools = listToolNames(t, cfg, &ReloadableConfig{
+		EnabledToolsets: []string{"pull_requests"},
+		ToolOverrides: map[string]ToolOverride{
+			"merge_pull_request": {Disabled: true},
+		},
+	})
+	require.Contains(t, tools, "get_pull_request")
+	require.NotContains(t, tools, "merge_pull_request")
+	require.NotContains(t, tools, "get_issue")
+
+	_, reloader, err := newMCPServer(cfg)
+	require.NoError(t, err)
+	require.Error(t, reloader.reload(ReloadableConfig{EnabledToolsets: []string{"nope"}}))
+}
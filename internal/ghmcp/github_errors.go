@@ -0,0 +1,102 @@
+package ghmcp
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"net/http"
+
+	"github.com/github/github-mcp-http/pkg/errors"
+	mcplog "github.com/github/github-mcp-http/pkg/log"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// githubErrorsMetaKey is the key of the error summary in the tool result _meta.
+const githubErrorsMetaKey = "github_errors"
+
+// ErrorMetrics receives every GitHub error collected during a tool call.
+type ErrorMetrics interface {
+	RecordGitHubError(tool string, summary errors.ErrorSummary)
+}
+
+// githubErrorCounters counts GitHub errors by tool, error type and status code. It is
+// published through expvar, and served by the metrics endpoint when one is enabled.
+var githubErrorCounters = expvar.NewMap("github_errors")
+
+// githubErrorsHandler serves githubErrorCounters in the format of expvar.Handler. The metrics
+// endpoint is not authenticated, so the other expvar variables, such as cmdline, are left out.
+func githubErrorsHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_, _ = fmt.Fprintf(w, "{\n%q: %s\n}\n", "github_errors", githubErrorCounters.String())
+}
+
+// expvarErrorMetrics is the default ErrorMetrics, backed by githubErrorCounters.
+type expvarErrorMetrics struct{}
+
+func (expvarErrorMetrics) RecordGitHubError(tool string, summary errors.ErrorSummary) {
+	githubErrorCounters.Add(fmt.Sprintf("%s.%s.%d", tool, summary.Type, summary.StatusCode), 1)
+}
+
+// githubErrorsMiddleware gathers the GitHub errors stored in the context by the tool handler,
+// logs them, records them in metrics and, when includeMeta is set, adds a summary of them
+// to the _meta of the tool result.
+func githubErrorsMiddleware(metrics ErrorMetrics, includeMeta bool) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			result, err := next(ctx, request)
+
+			summaries := errors.GetGitHubErrorSummaries(ctx)
+			if len(summaries) == 0 {
+				return result, err
+			}
+
+			logger := mcplog.FromContext(ctx)
+			for _, summary := range summaries {
+				attrs := []any{
+					"type", summary.Type,
+					"message", summary.Message,
+				}
+				if summary.StatusCode != 0 {
+					attrs = append(attrs, "status", summary.StatusCode)
+				}
+				if summary.RequestID != "" {
+					attrs = append(attrs, "github_request_id", summary.RequestID)
+				}
+				if summary.RateLimit != nil {
+					attrs = append(attrs,
+						"rate_limit", summary.RateLimit.Limit,
+						"rate_remaining", summary.RateLimit.Remaining,
+						"rate_reset", summary.RateLimit.Reset,
+					)
+				}
+				logger.Warn("github error", attrs...)
+
+				if metrics != nil {
+					metrics.RecordGitHubError(request.Params.Name, summary)
+				}
+			}
+
+			if includeMeta && result != nil {
+				if result.Meta == nil {
+					result.Meta = make(map[string]any)
+				}
+				result.Meta[githubErrorsMetaKey] = summaries
+			}
+			return result, err
+		}
+	}
+}
+
+// toolErrorMiddleware makes sure every tool error carries a structured ToolError with a category
+// and retry hints, including error results built without the pkg/errors helpers and Go errors
+// returned by the handler, which are turned into error results.
+func toolErrorMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		result, err := next(ctx, request)
+		if err != nil {
+			return errors.NewToolResultErrorFromError(ctx, err), nil
+		}
+		return errors.EnsureToolError(ctx, result), nil
+	}
+}
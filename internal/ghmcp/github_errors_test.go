@@ -0,0 +1,124 @@
+package ghmcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/github/github-mcp-http/pkg/errors"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+type recordedErrors struct {
+	tools     []string
+	summaries []errors.ErrorSummary
+}
+
+func (r *recordedErrors) RecordGitHubError(tool string, summary errors.ErrorSummary) {
+	r.tools = append(r.tools, tool)
+	r.summaries = append(r.summaries, summary)
+}
+
+func TestGitHubErrorsMiddleware(t *testing.T) {
+	failingHandler := func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		resp := &github.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+		return errors.NewGitHubAPIErrorResponse(ctx, "failed to get issue", resp, fmt.Errorf("not found")), nil
+	}
+	request := mcp.CallToolRequest{}
+	request.Params.Name = "get_issue"
+
+	t.Run("records errors and adds meta when enabled", func(t *testing.T) {
+		metrics := &recordedErrors{}
+		handler := githubErrorsMiddleware(metrics, true)(failingHandler)
+
+		result, err := handler(errors.ContextWithGitHubErrors(context.Background()), request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+
+		expected := []errors.ErrorSummary{{Type: errors.ErrorTypeAPI, Category: errors.CategoryNotFound, Message: "failed to get issue", StatusCode: http.StatusNotFound}}
+		require.Equal(t, []string{"get_issue"}, metrics.tools)
+		require.Equal(t, expected, metrics.summaries)
+		require.Equal(t, expected, result.Meta[githubErrorsMetaKey])
+	})
+
+	t.Run("leaves meta untouched when disabled", func(t *testing.T) {
+		metrics := &recordedErrors{}
+		handler := githubErrorsMiddleware(metrics, false)(failingHandler)
+
+		result, err := handler(errors.ContextWithGitHubErrors(context.Background()), request)
+		require.NoError(t, err)
+		require.Len(t, metrics.summaries, 1)
+		require.Nil(t, result.Meta)
+	})
+
+	t.Run("does nothing for successful calls", func(t *testing.T) {
+		metrics := &recordedErrors{}
+		handler := githubErrorsMiddleware(metrics, true)(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultText("ok"), nil
+		})
+
+		result, err := handler(errors.ContextWithGitHubErrors(context.Background()), request)
+		require.NoError(t, err)
+		require.Empty(t, metrics.summaries)
+		require.Nil(t, result.Meta)
+	})
+}
+
+func TestToolErrorMiddleware(t *testing.T) {
+	t.Run("turns Go errors into categorized error results", func(t *testing.T) {
+		handler := toolErrorMiddleware(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return nil, fmt.Errorf("failed to get GitHub client: %w", fmt.Errorf("connection refused"))
+		})
+
+		result, err := handler(errors.ContextWithGitHubErrors(context.Background()), mcp.CallToolRequest{})
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		require.Equal(t, errors.ToolError{
+			Category:  errors.CategoryUpstreamUnavailable,
+			Message:   "failed to get GitHub client: connection refused",
+			Retryable: true,
+		}, result.StructuredContent.(map[string]any)["error"])
+	})
+
+	t.Run("uses the collected GitHub error for Go errors", func(t *testing.T) {
+		handler := toolErrorMiddleware(func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			resp := &github.Response{Response: &http.Response{StatusCode: http.StatusForbidden}}
+			_, _ = errors.NewGitHubAPIErrorToCtx(ctx, "failed to get issue", resp, fmt.Errorf("forbidden"))
+			return nil, fmt.Errorf("failed to get issue")
+		})
+
+		result, err := handler(errors.ContextWithGitHubErrors(context.Background()), mcp.CallToolRequest{})
+		require.NoError(t, err)
+		toolErr := result.StructuredContent.(map[string]any)["error"].(errors.ToolError)
+		require.Equal(t, errors.CategoryForbidden, toolErr.Category)
+		require.Equal(t, "failed to get issue", toolErr.Message)
+	})
+
+	t.Run("categorizes plain error results", func(t *testing.T) {
+		handler := toolErrorMiddleware(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultError("missing required parameter: owner"), nil
+		})
+
+		result, err := handler(errors.ContextWithGitHubErrors(context.Background()), mcp.CallToolRequest{})
+		require.NoError(t, err)
+		require.Equal(t, errors.CategoryValidation, result.StructuredContent.(map[string]any)["error"].(errors.ToolError).Category)
+	})
+}
+
+func TestGitHubErrorsHandler(t *testing.T) {
+	expvarErrorMetrics{}.RecordGitHubError("get_metrics_test", errors.ErrorSummary{Type: "api", StatusCode: http.StatusNotFound})
+
+	recorder := httptest.NewRecorder()
+	githubErrorsHandler(recorder, httptest.NewRequest(http.MethodGet, "/debug/vars", nil))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var served map[string]map[string]int
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &served))
+	require.Len(t, served, 1, "only the GitHub error counters are served")
+	require.Equal(t, 1, served["github_errors"]["get_metrics_test.api.404"])
+}
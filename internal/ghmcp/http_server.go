@@ -14,6 +14,7 @@ import (
 	"time"
 
 	pkgErrors "github.com/github/github-mcp-http/pkg/errors"
+	mcplog "github.com/github/github-mcp-http/pkg/log"
 	"github.com/github/github-mcp-http/pkg/translations"
 	"github.com/mark3labs/mcp-go/server"
 )
@@ -31,6 +32,15 @@ type HTTPServerConfig struct {
 	HealthPath        string
 	ShutdownTimeout   time.Duration
 	LogFilePath       string
+	LogFormat         string
+	LogLevel          string
+	MetricsPath       string
+	IncludeErrorMeta  bool
+	ToolOverrides     map[string]ToolOverride
+	Limits            LimitConfig
+
+	// Reload, when set, is called on SIGHUP to re-read the reloadable settings.
+	Reload ReloadFunc
 }
 
 const (
@@ -60,53 +70,71 @@ func RunHTTPServer(cfg HTTPServerConfig) error {
 
 	translator, _ := translations.TranslationHelper()
 
-	ghServer, err := NewMCPServer(MCPServerConfig{
-		Version:           cfg.Version,
-		Host:              cfg.Host,
-		EnabledToolsets:   cfg.EnabledToolsets,
-		DynamicToolsets:   cfg.DynamicToolsets,
-		ReadOnly:          cfg.ReadOnly,
-		Translator:        translator,
-		ContentWindowSize: cfg.ContentWindowSize,
-		TokenProvider:     TokenFromContext,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to create MCP server: %w", err)
-	}
-
 	var logOutput io.Writer
-	var logFile *os.File
-	var slogHandler slog.Handler
 
+	// Without an explicit level, log at debug when writing to a file and info on stderr.
+	defaultLevel := slog.LevelInfo
 	if strings.TrimSpace(cfg.LogFilePath) != "" {
 		file, fileErr := os.OpenFile(cfg.LogFilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
 		if fileErr != nil {
 			return fmt.Errorf("failed to open log file: %w", fileErr)
 		}
+		defer func() { _ = file.Close() }()
 		logOutput = file
-		logFile = file
-		slogHandler = slog.NewTextHandler(logOutput, &slog.HandlerOptions{Level: slog.LevelDebug})
+		defaultLevel = slog.LevelDebug
 	} else {
 		logOutput = os.Stderr
-		slogHandler = slog.NewTextHandler(logOutput, &slog.HandlerOptions{Level: slog.LevelInfo})
 	}
 
+	logLevel := &slog.LevelVar{}
+	logLevel.Set(defaultLevel)
+	if strings.TrimSpace(cfg.LogLevel) != "" {
+		level, levelErr := mcplog.ParseLevel(cfg.LogLevel)
+		if levelErr != nil {
+			return levelErr
+		}
+		logLevel.Set(level)
+	}
+
+	slogHandler, err := mcplog.NewHandler(logOutput, cfg.LogFormat, logLevel)
+	if err != nil {
+		return err
+	}
 	logger := slog.New(slogHandler)
-	if logFile != nil {
-		defer func() { _ = logFile.Close() }()
+
+	ghServer, reloader, err := newMCPServer(MCPServerConfig{
+		Version:           cfg.Version,
+		Host:              cfg.Host,
+		EnabledToolsets:   cfg.EnabledToolsets,
+		DynamicToolsets:   cfg.DynamicToolsets,
+		ReadOnly:          cfg.ReadOnly,
+		Translator:        translator,
+		ContentWindowSize: cfg.ContentWindowSize,
+		TokenProvider:     TokenFromContext,
+		ToolOverrides:     cfg.ToolOverrides,
+		Logger:            logger,
+		IncludeErrorMeta:  cfg.IncludeErrorMeta,
+		Limits:            cfg.Limits,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create MCP server: %w", err)
 	}
+
 	httpServer := &http.Server{Addr: listenAddress}
 
 	streamServer := server.NewStreamableHTTPServer(
 		ghServer,
 		server.WithStreamableHTTPServer(httpServer),
 		server.WithHTTPContextFunc(func(ctx context.Context, r *http.Request) context.Context {
-			return pkgErrors.ContextWithGitHubErrors(ctx)
+			return contextWithRequestInfo(pkgErrors.ContextWithGitHubErrors(ctx))
 		}),
 	)
 
 	mux := http.NewServeMux()
 	mux.HandleFunc(healthPath, healthHandler)
+	if strings.TrimSpace(cfg.MetricsPath) != "" {
+		mux.HandleFunc(normalizePath(cfg.MetricsPath, ""), githubErrorsHandler)
+	}
 
 	protectedHandler := tokenMiddleware(streamServer)
 	mux.Handle(endpointPath, protectedHandler)
@@ -116,6 +144,13 @@ func RunHTTPServer(cfg HTTPServerConfig) error {
 
 	httpServer.Handler = mux
 
+	if cfg.Reload != nil {
+		hupCh := make(chan os.Signal, 1)
+		signal.Notify(hupCh, syscall.SIGHUP)
+		defer signal.Stop(hupCh)
+		go watchReload(ctx, hupCh, cfg.Reload, reloader, logLevel, logger)
+	}
+
 	errCh := make(chan error, 1)
 	go func() {
 		logger.Info("starting HTTP server", "address", listenAddress, "endpoint", endpointPath, "health", healthPath, "dynamicToolsets", cfg.DynamicToolsets, "readOnly", cfg.ReadOnly)
@@ -155,6 +190,38 @@ func RunHTTPServer(cfg HTTPServerConfig) error {
 	return nil
 }
 
+// watchReload applies the reloadable configuration each time a signal arrives on sigCh.
+// A configuration that fails to load or validate is logged and the previous settings are kept.
+func watchReload(ctx context.Context, sigCh <-chan os.Signal, reload ReloadFunc, reloader *toolReloader, logLevel *slog.LevelVar, logger *slog.Logger) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sigCh:
+			reloadCfg, err := reload()
+			if err != nil {
+				logger.Error("failed to reload configuration, keeping previous settings", "error", err)
+				continue
+			}
+			var level slog.Level
+			if reloadCfg.LogLevel != "" {
+				if level, err = mcplog.ParseLevel(reloadCfg.LogLevel); err != nil {
+					logger.Error("failed to reload configuration, keeping previous settings", "error", err)
+					continue
+				}
+			}
+			if err := reloader.reload(reloadCfg); err != nil {
+				logger.Error("failed to apply reloaded configuration, keeping previous settings", "error", err)
+				continue
+			}
+			if reloadCfg.LogLevel != "" {
+				logLevel.Set(level)
+			}
+			logger.Info("configuration reloaded", "toolsets", reloadCfg.EnabledToolsets, "disabledTools", disabledTools(reloadCfg.ToolOverrides))
+		}
+	}
+}
+
 func normalizePath(path string, fallback string) string {
 	trimmed := strings.TrimSpace(path)
 	if trimmed == "" {
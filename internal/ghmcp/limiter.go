@@ -0,0 +1,193 @@
+package ghmcp
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/github/github-mcp-http/pkg/errors"
+)
+
+// LimitConfig caps the GitHub API usage of each token. A zero value disables the limit.
+type LimitConfig struct {
+	// MaxConcurrent is the maximum number of GitHub requests in flight at once.
+	MaxConcurrent int
+	// RequestsPerMinute is the sustained number of GitHub requests allowed per minute.
+	RequestsPerMinute int
+	// DailyBudget is the number of GitHub requests allowed per UTC day.
+	DailyBudget int
+}
+
+func (c LimitConfig) enabled() bool {
+	return c.MaxConcurrent > 0 || c.RequestsPerMinute > 0 || c.DailyBudget > 0
+}
+
+// idleLimiterEntryTTL is how long the usage of a token is kept after its last request.
+const idleLimiterEntryTTL = 24 * time.Hour
+
+// tokenLimiter enforces a LimitConfig per token. Tokens are only held as a SHA-256 hash.
+type tokenLimiter struct {
+	cfg LimitConfig
+	now func() time.Time
+
+	mu        sync.Mutex
+	usage     map[string]*tokenUsage
+	lastSweep time.Time
+}
+
+type tokenUsage struct {
+	inFlight int
+
+	// token bucket for RequestsPerMinute
+	tokens     float64
+	lastRefill time.Time
+
+	day      time.Time
+	dayCount int
+
+	lastSeen time.Time
+}
+
+func newTokenLimiter(cfg LimitConfig) *tokenLimiter {
+	return &tokenLimiter{
+		cfg:   cfg,
+		now:   time.Now,
+		usage: make(map[string]*tokenUsage),
+	}
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// acquire reserves a request for the token, returning a function that must be called once
+// the request has completed. It returns an *errors.LocalRateLimitError when a limit is exceeded.
+func (l *tokenLimiter) acquire(key string) (func(), error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	usage, ok := l.usage[key]
+	if !ok {
+		usage = &tokenUsage{
+			tokens:     float64(l.cfg.RequestsPerMinute),
+			lastRefill: now,
+		}
+		l.usage[key] = usage
+	}
+	usage.lastSeen = now
+
+	if l.cfg.MaxConcurrent > 0 && usage.inFlight >= l.cfg.MaxConcurrent {
+		return nil, &errors.LocalRateLimitError{
+			Reason:     fmt.Sprintf("maximum of %d concurrent requests reached", l.cfg.MaxConcurrent),
+			RetryAfter: time.Second,
+		}
+	}
+
+	if l.cfg.DailyBudget > 0 {
+		day := now.UTC().Truncate(24 * time.Hour)
+		if !usage.day.Equal(day) {
+			usage.day = day
+			usage.dayCount = 0
+		}
+		if usage.dayCount >= l.cfg.DailyBudget {
+			return nil, &errors.LocalRateLimitError{
+				Reason:     fmt.Sprintf("daily budget of %d requests used", l.cfg.DailyBudget),
+				RetryAfter: day.Add(24 * time.Hour).Sub(now),
+			}
+		}
+	}
+
+	if l.cfg.RequestsPerMinute > 0 {
+		ratePerSecond := float64(l.cfg.RequestsPerMinute) / 60
+		elapsed := now.Sub(usage.lastRefill).Seconds()
+		usage.tokens = min(float64(l.cfg.RequestsPerMinute), usage.tokens+elapsed*ratePerSecond)
+		usage.lastRefill = now
+		if usage.tokens < 1 {
+			wait := time.Duration((1 - usage.tokens) / ratePerSecond * float64(time.Second))
+			return nil, &errors.LocalRateLimitError{
+				Reason:     fmt.Sprintf("limit of %d requests per minute reached", l.cfg.RequestsPerMinute),
+				RetryAfter: wait,
+			}
+		}
+		usage.tokens--
+	}
+
+	if l.cfg.DailyBudget > 0 {
+		usage.dayCount++
+	}
+	usage.inFlight++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			usage.inFlight--
+			l.mu.Unlock()
+		})
+	}, nil
+}
+
+// sweep forgets tokens that have been idle for longer than idleLimiterEntryTTL. It must be
+// called with l.mu held.
+func (l *tokenLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < time.Hour {
+		return
+	}
+	l.lastSweep = now
+	for key, usage := range l.usage {
+		if usage.inFlight == 0 && now.Sub(usage.lastSeen) > idleLimiterEntryTTL {
+			delete(l.usage, key)
+		}
+	}
+}
+
+// limitTransport applies the token limiter to every request sent with a given token.
+type limitTransport struct {
+	transport http.RoundTripper
+	limiter   *tokenLimiter
+	key       string
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.limiter.acquire(t.key)
+	if err != nil {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return nil, err
+	}
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+	// The request stays in flight until the caller has read or closed the response body.
+	resp.Body = &releaseOnCloseBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+type releaseOnCloseBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseOnCloseBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err == io.EOF {
+		b.release()
+	}
+	return n, err
+}
+
+func (b *releaseOnCloseBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
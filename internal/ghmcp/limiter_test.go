@@ -0,0 +1,132 @@
+package ghmcp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-http/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenLimiter(t *testing.T) {
+	t.Run("max concurrent requests", func(t *testing.T) {
+		limiter := newTokenLimiter(LimitConfig{MaxConcurrent: 2})
+
+		release1, err := limiter.acquire("a")
+		require.NoError(t, err)
+		_, err = limiter.acquire("a")
+		require.NoError(t, err)
+
+		_, err = limiter.acquire("a")
+		var limitErr *errors.LocalRateLimitError
+		require.ErrorAs(t, err, &limitErr)
+		require.Equal(t, time.Second, limitErr.RetryAfter)
+
+		// Other tokens are not affected.
+		_, err = limiter.acquire("b")
+		require.NoError(t, err)
+
+		release1()
+		release1()
+		_, err = limiter.acquire("a")
+		require.NoError(t, err)
+		_, err = limiter.acquire("a")
+		require.Error(t, err)
+	})
+
+	t.Run("requests per minute", func(t *testing.T) {
+		now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		limiter := newTokenLimiter(LimitConfig{RequestsPerMinute: 2})
+		limiter.now = func() time.Time { return now }
+
+		for i := 0; i < 2; i++ {
+			release, err := limiter.acquire("a")
+			require.NoError(t, err)
+			release()
+		}
+		_, err := limiter.acquire("a")
+		var limitErr *errors.LocalRateLimitError
+		require.ErrorAs(t, err, &limitErr)
+		require.Equal(t, 30*time.Second, limitErr.RetryAfter)
+
+		now = now.Add(30 * time.Second)
+		_, err = limiter.acquire("a")
+		require.NoError(t, err)
+	})
+
+	t.Run("daily budget", func(t *testing.T) {
+		now := time.Date(2025, 1, 1, 18, 0, 0, 0, time.UTC)
+		limiter := newTokenLimiter(LimitConfig{DailyBudget: 1})
+		limiter.now = func() time.Time { return now }
+
+		release, err := limiter.acquire("a")
+		require.NoError(t, err)
+		release()
+
+		_, err = limiter.acquire("a")
+		var limitErr *errors.LocalRateLimitError
+		require.ErrorAs(t, err, &limitErr)
+		require.Equal(t, 6*time.Hour, limitErr.RetryAfter)
+
+		now = now.Add(6 * time.Hour)
+		_, err = limiter.acquire("a")
+		require.NoError(t, err)
+	})
+
+	t.Run("idle tokens are forgotten", func(t *testing.T) {
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		limiter := newTokenLimiter(LimitConfig{DailyBudget: 10})
+		limiter.now = func() time.Time { return now }
+
+		release, err := limiter.acquire("a")
+		require.NoError(t, err)
+		release()
+
+		now = now.Add(idleLimiterEntryTTL + time.Hour)
+		_, err = limiter.acquire("b")
+		require.NoError(t, err)
+		require.NotContains(t, limiter.usage, "a")
+		require.Contains(t, limiter.usage, "b")
+	})
+}
+
+func TestGitHubClientFactory_AppliesLimits(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	baseURL, err := url.Parse(srv.URL + "/")
+	require.NoError(t, err)
+	factory := newGitHubClientFactory("test", apiHost{baseRESTURL: baseURL, uploadURL: baseURL, rawURL: baseURL}, TokenFromContext)
+	factory.limiter = newTokenLimiter(LimitConfig{DailyBudget: 1})
+
+	get := func(token string) error {
+		ctx := ContextWithToken(context.Background(), token)
+		client, err := factory.getRESTClient(ctx)
+		require.NoError(t, err)
+		req, err := client.NewRequest(http.MethodGet, "user", nil)
+		require.NoError(t, err)
+		_, err = client.Do(ctx, req, nil)
+		return err
+	}
+
+	require.NoError(t, get("token-a"))
+	err = get("token-a")
+	var limitErr *errors.LocalRateLimitError
+	require.ErrorAs(t, err, &limitErr)
+	require.NoError(t, get("token-b"))
+	require.Equal(t, 2, calls)
+
+	result := errors.NewGitHubAPIErrorResponse(context.Background(), "failed to get user", nil, err)
+	toolErr := result.StructuredContent.(map[string]any)["error"].(errors.ToolError)
+	require.Equal(t, errors.CategoryRateLimited, toolErr.Category)
+	require.True(t, toolErr.Retryable)
+	require.Positive(t, toolErr.RetryAfterSeconds)
+}
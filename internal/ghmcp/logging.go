@@ -0,0 +1,147 @@
+package ghmcp
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	mcplog "github.com/github/github-mcp-http/pkg/log"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// githubRequestIDHeader is the header GitHub uses to identify a request in support tickets.
+const githubRequestIDHeader = "X-GitHub-Request-Id"
+
+type requestInfoKey struct{}
+
+// requestInfo carries the MCP request ID from the hooks to the tool middleware. As with
+// GitHub errors, the context is not propagated from hooks, so a pointer is stored up front
+// and filled in later.
+type requestInfo struct {
+	mu        sync.Mutex
+	requestID string
+}
+
+// contextWithRequestInfo stores an empty requestInfo in the context, or resets an existing one.
+func contextWithRequestInfo(ctx context.Context) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if info, ok := ctx.Value(requestInfoKey{}).(*requestInfo); ok {
+		info.setRequestID(nil)
+		return ctx
+	}
+	return context.WithValue(ctx, requestInfoKey{}, &requestInfo{})
+}
+
+func (i *requestInfo) setRequestID(id any) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if id == nil {
+		i.requestID = ""
+		return
+	}
+	i.requestID = fmt.Sprint(id)
+}
+
+func (i *requestInfo) getRequestID() string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.requestID
+}
+
+// recordRequestID is a BeforeCallTool hook that captures the JSON-RPC request ID.
+func recordRequestID(ctx context.Context, id any, _ *mcp.CallToolRequest) {
+	if info, ok := ctx.Value(requestInfoKey{}).(*requestInfo); ok {
+		info.setRequestID(id)
+	}
+}
+
+type githubRequestIDsKey struct{}
+
+// githubRequestIDs collects the X-GitHub-Request-Id of every GitHub call made by a tool.
+type githubRequestIDs struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+func (g *githubRequestIDs) add(id string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.ids = append(g.ids, id)
+}
+
+func (g *githubRequestIDs) list() []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]string(nil), g.ids...)
+}
+
+// toolLoggingMiddleware passes a logger annotated with the session ID, MCP request ID and
+// tool name to the tool handler through the context, and logs the outcome of every call
+// together with the GitHub request IDs it produced.
+func toolLoggingMiddleware(logger *slog.Logger) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			callLogger := logger.With("tool", request.Params.Name)
+			if session := server.ClientSessionFromContext(ctx); session != nil && session.SessionID() != "" {
+				callLogger = callLogger.With("session_id", session.SessionID())
+			}
+			if info, ok := ctx.Value(requestInfoKey{}).(*requestInfo); ok {
+				if id := info.getRequestID(); id != "" {
+					callLogger = callLogger.With("mcp_request_id", id)
+				}
+			}
+
+			ghRequestIDs := &githubRequestIDs{}
+			ctx = context.WithValue(ctx, githubRequestIDsKey{}, ghRequestIDs)
+			ctx = mcplog.ContextWithLogger(ctx, callLogger)
+
+			start := time.Now()
+			result, err := next(ctx, request)
+			attrs := []any{
+				"duration", time.Since(start),
+				"github_request_ids", ghRequestIDs.list(),
+			}
+
+			switch {
+			case err != nil:
+				callLogger.Error("tool call failed", append(attrs, "error", err)...)
+			case result != nil && result.IsError:
+				callLogger.Warn("tool call returned an error result", attrs...)
+			default:
+				callLogger.Info("tool call completed", attrs...)
+			}
+			return result, err
+		}
+	}
+}
+
+// requestLogTransport logs every GitHub API call with the logger found in the request
+// context, and records the X-GitHub-Request-Id for the tool call being served.
+type requestLogTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *requestLogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	logger := mcplog.FromContext(ctx)
+
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		logger.Debug("github request failed", "method", req.Method, "path", req.URL.Path, "duration", time.Since(start), "error", err)
+		return resp, err
+	}
+
+	ghRequestID := resp.Header.Get(githubRequestIDHeader)
+	if ids, ok := ctx.Value(githubRequestIDsKey{}).(*githubRequestIDs); ok && ghRequestID != "" {
+		ids.add(ghRequestID)
+	}
+	logger.Debug("github request", "method", req.Method, "path", req.URL.Path, "status", resp.StatusCode, "duration", time.Since(start), "github_request_id", ghRequestID)
+	return resp, nil
+}
@@ -0,0 +1,63 @@
+package ghmcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	mcplog "github.com/github/github-mcp-http/pkg/log"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToolLoggingMiddleware(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set(githubRequestIDHeader, "ABCD:1234")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	handler := toolLoggingMiddleware(logger)(func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		mcplog.FromContext(ctx).Info("inside handler")
+
+		client := &http.Client{Transport: &requestLogTransport{transport: http.DefaultTransport}}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/repos/o/r", nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return mcp.NewToolResultText("ok"), nil
+	})
+
+	ctx := contextWithRequestInfo(context.Background())
+	request := mcp.CallToolRequest{}
+	request.Params.Name = "get_me"
+	recordRequestID(ctx, 42, &request)
+
+	_, err := handler(ctx, request)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+
+	records := make([]map[string]any, len(lines))
+	for i, line := range lines {
+		require.NoError(t, json.Unmarshal([]byte(line), &records[i]))
+		require.Equal(t, "get_me", records[i]["tool"])
+		require.Equal(t, "42", records[i]["mcp_request_id"])
+	}
+
+	require.Equal(t, "inside handler", records[0]["msg"])
+	require.Equal(t, "github request", records[1]["msg"])
+	require.Equal(t, "ABCD:1234", records[1]["github_request_id"])
+	require.Equal(t, "tool call completed", records[2]["msg"])
+	require.Equal(t, []any{"ABCD:1234"}, records[2]["github_request_ids"])
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/github/github-mcp-http/pkg/github"
 	mcplog "github.com/github/github-mcp-http/pkg/log"
 	"github.com/github/github-mcp-http/pkg/raw"
+	"github.com/github/github-mcp-http/pkg/toolsets"
 	"github.com/github/github-mcp-http/pkg/translations"
 	gogithub "github.com/google/go-github/v74/github"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -54,6 +55,21 @@ type MCPServerConfig struct {
 
 	// Content window size
 	ContentWindowSize int
+
+	// ToolOverrides customises the description or title of individual tools, or disables them
+	ToolOverrides map[string]ToolOverride
+
+	// Logger, when set, is annotated per tool call and passed to tool handlers through the context
+	Logger *slog.Logger
+
+	// ErrorMetrics records the GitHub errors of every tool call. When nil, errors are counted in expvar.
+	ErrorMetrics ErrorMetrics
+
+	// IncludeErrorMeta adds a summary of the GitHub errors of a tool call to the _meta of its result
+	IncludeErrorMeta bool
+
+	// Limits caps the concurrency, rate and daily volume of GitHub requests per token
+	Limits LimitConfig
 }
 
 const stdioServerLogPrefix = "stdioserver"
@@ -62,9 +78,14 @@ const stdioServerLogPrefix = "stdioserver"
 type TokenProviderFunc func(context.Context) (string, error)
 
 func NewMCPServer(cfg MCPServerConfig) (*server.MCPServer, error) {
+	ghServer, _, err := newMCPServer(cfg)
+	return ghServer, err
+}
+
+func newMCPServer(cfg MCPServerConfig) (*server.MCPServer, *toolReloader, error) {
 	apiHost, err := parseAPIHost(cfg.Host)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse API host: %w", err)
+		return nil, nil, fmt.Errorf("failed to parse API host: %w", err)
 	}
 
 	tokenProvider := cfg.TokenProvider
@@ -79,6 +100,9 @@ func NewMCPServer(cfg MCPServerConfig) (*server.MCPServer, error) {
 	}
 
 	clientFactory := newGitHubClientFactory(cfg.Version, apiHost, tokenProvider)
+	if cfg.Limits.enabled() {
+		clientFactory.limiter = newTokenLimiter(cfg.Limits)
+	}
 
 	// When a client sends an initialize request, update the user agent to include the client info.
 	beforeInit := func(_ context.Context, _ any, message *mcp.InitializeRequest) {
@@ -94,6 +118,7 @@ func NewMCPServer(cfg MCPServerConfig) (*server.MCPServer, error) {
 
 	hooks := &server.Hooks{
 		OnBeforeInitialize: []server.OnBeforeInitializeFunc{beforeInit},
+		OnBeforeCallTool:   []server.OnBeforeCallToolFunc{recordRequestID},
 		OnBeforeAny: []server.BeforeAnyHookFunc{
 			func(ctx context.Context, _ any, _ mcp.MCPMethod, _ any) {
 				// Ensure the context is cleared of any previous errors
@@ -103,42 +128,117 @@ func NewMCPServer(cfg MCPServerConfig) (*server.MCPServer, error) {
 		},
 	}
 
-	enabledToolsets := cfg.EnabledToolsets
-	if cfg.DynamicToolsets {
-		// filter "all" from the enabled toolsets
-		enabledToolsets = make([]string, 0, len(cfg.EnabledToolsets))
-		for _, toolset := range cfg.EnabledToolsets {
-			if toolset != "all" {
-				enabledToolsets = append(enabledToolsets, toolset)
-			}
-		}
-	}
+	enabledToolsets := filterEnabledToolsets(cfg.EnabledToolsets, cfg.DynamicToolsets)
 
 	// Generate instructions based on enabled toolsets
 	instructions := github.GenerateInstructions(enabledToolsets)
 
-	ghServer := github.NewServer(cfg.Version,
+	serverOpts := []server.ServerOption{
 		server.WithInstructions(instructions),
 		server.WithHooks(hooks),
+	}
+	if cfg.Logger != nil {
+		serverOpts = append(serverOpts, server.WithToolHandlerMiddleware(toolLoggingMiddleware(cfg.Logger)))
+	}
+	errorMetrics := cfg.ErrorMetrics
+	if errorMetrics == nil {
+		errorMetrics = expvarErrorMetrics{}
+	}
+	serverOpts = append(serverOpts,
+		server.WithToolHandlerMiddleware(githubErrorsMiddleware(errorMetrics, cfg.IncludeErrorMeta)),
+		server.WithToolHandlerMiddleware(toolErrorMiddleware),
 	)
 
-	// Create default toolsets
-	tsg := github.DefaultToolsetGroup(cfg.ReadOnly, clientFactory.getRESTClient, clientFactory.getGraphQLClient, clientFactory.getRawClient, cfg.Translator, cfg.ContentWindowSize)
-	err = tsg.EnableToolsets(enabledToolsets)
+	ghServer := github.NewServer(cfg.Version, serverOpts...)
 
+	reloader := &toolReloader{
+		cfg:           cfg,
+		server:        ghServer,
+		clientFactory: clientFactory,
+	}
+
+	translator := translations.WithOverrides(cfg.Translator, toolOverrideTranslations(cfg.ToolOverrides))
+
+	// Create default toolsets
+	tsg, err := reloader.toolsetGroup(enabledToolsets, translator)
 	if err != nil {
-		return nil, fmt.Errorf("failed to enable toolsets: %w", err)
+		return nil, nil, err
 	}
 
 	// Register all mcp functionality with the server
 	tsg.RegisterAll(ghServer)
 
 	if cfg.DynamicToolsets {
-		dynamic := github.InitDynamicToolset(ghServer, tsg, cfg.Translator)
+		dynamic := github.InitDynamicToolset(ghServer, tsg, translator)
 		dynamic.RegisterTools(ghServer)
 	}
 
-	return ghServer, nil
+	if disabled := disabledTools(cfg.ToolOverrides); len(disabled) > 0 {
+		ghServer.DeleteTools(disabled...)
+	}
+
+	return ghServer, reloader, nil
+}
+
+// filterEnabledToolsets removes "all" from the enabled toolsets when dynamic toolsets are in use.
+func filterEnabledToolsets(toolsets []string, dynamicToolsets bool) []string {
+	if !dynamicToolsets {
+		return toolsets
+	}
+	filtered := make([]string, 0, len(toolsets))
+	for _, toolset := range toolsets {
+		if toolset != "all" {
+			filtered = append(filtered, toolset)
+		}
+	}
+	return filtered
+}
+
+// toolReloader rebuilds the tools of a running server when its configuration changes.
+// Resources, prompts and server instructions are fixed when the server is created.
+type toolReloader struct {
+	cfg           MCPServerConfig
+	server        *server.MCPServer
+	clientFactory *gitHubClientFactory
+}
+
+func (r *toolReloader) toolsetGroup(enabledToolsets []string, t translations.TranslationHelperFunc) (*toolsets.ToolsetGroup, error) {
+	tsg := github.DefaultToolsetGroup(r.cfg.ReadOnly, r.clientFactory.getRESTClient, r.clientFactory.getGraphQLClient, r.clientFactory.getRawClient, t, r.cfg.ContentWindowSize)
+	if err := tsg.EnableToolsets(enabledToolsets); err != nil {
+		return nil, fmt.Errorf("failed to enable toolsets: %w", err)
+	}
+	return tsg, nil
+}
+
+// reload replaces the registered tools with those of the given toolsets, re-reading
+// translations and applying the tool overrides.
+func (r *toolReloader) reload(cfg ReloadableConfig) error {
+	t, _ := translations.TranslationHelper()
+	t = translations.WithOverrides(t, toolOverrideTranslations(cfg.ToolOverrides))
+
+	tsg, err := r.toolsetGroup(filterEnabledToolsets(cfg.EnabledToolsets, r.cfg.DynamicToolsets), t)
+	if err != nil {
+		return err
+	}
+
+	var tools []server.ServerTool
+	for _, toolset := range tsg.Toolsets {
+		tools = append(tools, toolset.GetActiveTools()...)
+	}
+	if r.cfg.DynamicToolsets {
+		tools = append(tools, github.InitDynamicToolset(r.server, tsg, t).GetActiveTools()...)
+	}
+
+	disabled := disabledTools(cfg.ToolOverrides)
+	active := make([]server.ServerTool, 0, len(tools))
+	for _, tool := range tools {
+		if !containsString(disabled, tool.Tool.Name) {
+			active = append(active, tool)
+		}
+	}
+
+	r.server.SetTools(active...)
+	return nil
 }
 
 type gitHubClientFactory struct {
@@ -146,6 +246,7 @@ type gitHubClientFactory struct {
 	apiHost           apiHost
 	defaultUserAgent  string
 	userAgentOverride atomic.Pointer[string]
+	limiter           *tokenLimiter
 }
 
 func newGitHubClientFactory(version string, host apiHost, provider TokenProviderFunc) *gitHubClientFactory {
@@ -189,12 +290,26 @@ func (f *gitHubClientFactory) resolveToken(ctx context.Context) (string, error)
 	return token, nil
 }
 
+// transport returns the base transport for requests made with the given token, applying
+// the per-token limits when they are configured.
+func (f *gitHubClientFactory) transport(token string) http.RoundTripper {
+	transport := http.DefaultTransport
+	if f.limiter != nil {
+		transport = &limitTransport{
+			transport: transport,
+			limiter:   f.limiter,
+			key:       hashToken(token),
+		}
+	}
+	return &requestLogTransport{transport: transport}
+}
+
 func (f *gitHubClientFactory) getRESTClient(ctx context.Context) (*gogithub.Client, error) {
 	token, err := f.resolveToken(ctx)
 	if err != nil {
 		return nil, err
 	}
-	baseClient := gogithub.NewClient(nil)
+	baseClient := gogithub.NewClient(&http.Client{Transport: f.transport(token)})
 	baseClient.BaseURL = f.apiHost.baseRESTURL
 	baseClient.UploadURL = f.apiHost.uploadURL
 	baseClient.UserAgent = f.currentUserAgent()
@@ -208,7 +323,7 @@ func (f *gitHubClientFactory) getGraphQLClient(ctx context.Context) (*githubv4.C
 	if err != nil {
 		return nil, err
 	}
-	transport := http.RoundTripper(http.DefaultTransport)
+	transport := f.transport(token)
 	transport = &bearerAuthTransport{
 		transport: transport,
 		token:     token,
@@ -360,7 +475,7 @@ func newDotcomHost() (apiHost, error) {
 		return apiHost{}, fmt.Errorf("failed to parse dotcom GraphQL URL: %w", err)
 	}
 
-	uploadURL, err := url.Parse("https://uploads.github.com")
+	uploadURL, err := url.Parse("https://uploads.github.com/")
 	if err != nil {
 		return apiHost{}, fmt.Errorf("failed to parse dotcom Upload URL: %w", err)
 	}
@@ -399,7 +514,7 @@ func newGHECHost(hostname string) (apiHost, error) {
 		return apiHost{}, fmt.Errorf("failed to parse GHEC GraphQL URL: %w", err)
 	}
 
-	uploadURL, err := url.Parse(fmt.Sprintf("https://uploads.%s", u.Hostname()))
+	uploadURL, err := url.Parse(fmt.Sprintf("https://uploads.%s/", u.Hostname()))
 	if err != nil {
 		return apiHost{}, fmt.Errorf("failed to parse GHEC Upload URL: %w", err)
 	}
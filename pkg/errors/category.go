@@ -0,0 +1,282 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Category is a machine-readable classification of a tool error.
+type Category string
+
+const (
+	CategoryNotFound            Category = "not_found"
+	CategoryForbidden           Category = "forbidden"
+	CategoryRateLimited         Category = "rate_limited"
+	CategoryValidation          Category = "validation"
+	CategoryConflict            Category = "conflict"
+	CategoryUpstreamUnavailable Category = "upstream_unavailable"
+)
+
+// ToolError is the structured content attached to every tool error result, under the "error" key.
+type ToolError struct {
+	Category          Category `json:"category"`
+	Message           string   `json:"message"`
+	StatusCode        int      `json:"status_code,omitempty"`
+	Retryable         bool     `json:"retryable"`
+	RetryAfterSeconds int      `json:"retry_after_seconds,omitempty"`
+}
+
+// LocalRateLimitError is returned by the HTTP transport when a request is rejected by the
+// server's own per-token limits before it reaches GitHub.
+type LocalRateLimitError struct {
+	Reason     string
+	RetryAfter time.Duration
+}
+
+func (e *LocalRateLimitError) Error() string {
+	return fmt.Sprintf("request rejected by server rate limit: %s", e.Reason)
+}
+
+// Retryable reports whether a request that failed with this category may succeed if retried unchanged.
+func (c Category) Retryable() bool {
+	return c == CategoryRateLimited || c == CategoryUpstreamUnavailable
+}
+
+// CategoryForStatus maps an HTTP status code returned by GitHub to an error category.
+func CategoryForStatus(statusCode int) Category {
+	switch {
+	case statusCode == http.StatusNotFound || statusCode == http.StatusGone:
+		return CategoryNotFound
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return CategoryForbidden
+	case statusCode == http.StatusTooManyRequests:
+		return CategoryRateLimited
+	case statusCode == http.StatusConflict || statusCode == http.StatusPreconditionFailed:
+		return CategoryConflict
+	case statusCode >= 400 && statusCode < 500:
+		return CategoryValidation
+	default:
+		return CategoryUpstreamUnavailable
+	}
+}
+
+// Category classifies the API error from the GitHub response. A 403 caused by an exhausted
+// primary or secondary rate limit is reported as rate_limited rather than forbidden.
+func (e *GitHubAPIError) Category() Category {
+	var rateLimitErr *github.RateLimitError
+	var abuseErr *github.AbuseRateLimitError
+	var localErr *LocalRateLimitError
+	if errors.As(e.Err, &rateLimitErr) || errors.As(e.Err, &abuseErr) || errors.As(e.Err, &localErr) {
+		return CategoryRateLimited
+	}
+	if e.Response == nil || e.Response.Response == nil {
+		return CategoryUpstreamUnavailable
+	}
+	category := CategoryForStatus(e.Response.StatusCode)
+	if category == CategoryForbidden && e.Response.Rate.Limit > 0 && e.Response.Rate.Remaining == 0 {
+		return CategoryRateLimited
+	}
+	return category
+}
+
+// retryAfter returns how long to wait before retrying, from the Retry-After header, a
+// secondary rate limit error or the primary rate limit reset time.
+func (e *GitHubAPIError) retryAfter() time.Duration {
+	var localErr *LocalRateLimitError
+	if errors.As(e.Err, &localErr) {
+		return localErr.RetryAfter
+	}
+	var abuseErr *github.AbuseRateLimitError
+	if errors.As(e.Err, &abuseErr) && abuseErr.RetryAfter != nil {
+		return *abuseErr.RetryAfter
+	}
+	if e.Response == nil || e.Response.Response == nil {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(e.Response.Header.Get("Retry-After")); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if e.Response.Rate.Remaining == 0 && !e.Response.Rate.Reset.IsZero() {
+		if wait := time.Until(e.Response.Rate.Reset.Time); wait > 0 {
+			return wait
+		}
+	}
+	return 0
+}
+
+// ToolError describes the API error for the structured content of a tool result.
+func (e *GitHubAPIError) ToolError() ToolError {
+	category := e.Category()
+	toolErr := ToolError{
+		Category:  category,
+		Message:   e.Error(),
+		Retryable: category.Retryable(),
+	}
+	if e.Response != nil && e.Response.Response != nil {
+		toolErr.StatusCode = e.Response.StatusCode
+	}
+	if category == CategoryRateLimited {
+		toolErr.RetryAfterSeconds = int(math.Ceil(e.retryAfter().Seconds()))
+	}
+	return toolErr
+}
+
+// graphQLStatusPattern matches the status code of a non-200 GraphQL response reported by githubv4.
+var graphQLStatusPattern = regexp.MustCompile(`non-200 OK status code: (\d{3})`)
+
+// Category classifies the GraphQL error. githubv4 does not expose the "type" of GraphQL
+// errors, so the category is derived from the HTTP status of the response when it failed,
+// and otherwise from the messages GitHub uses for each error type.
+func (e *GitHubGraphQLError) Category() Category {
+	if e.Err == nil {
+		return CategoryValidation
+	}
+	var localErr *LocalRateLimitError
+	if errors.As(e.Err, &localErr) {
+		return CategoryRateLimited
+	}
+	msg := e.Err.Error()
+	if match := graphQLStatusPattern.FindStringSubmatch(msg); match != nil {
+		statusCode, _ := strconv.Atoi(match[1])
+		return CategoryForStatus(statusCode)
+	}
+
+	lower := strings.ToLower(msg)
+	switch {
+	case strings.Contains(lower, "rate limit"):
+		return CategoryRateLimited
+	case strings.Contains(lower, "could not resolve to"), strings.Contains(lower, "not found"):
+		return CategoryNotFound
+	case strings.Contains(lower, "not accessible by"), strings.Contains(lower, "must have"),
+		strings.Contains(lower, "permission"), strings.Contains(lower, "forbidden"):
+		return CategoryForbidden
+	case strings.Contains(lower, "conflict"), strings.Contains(lower, "already exists"):
+		return CategoryConflict
+	case strings.Contains(lower, "timeout"), strings.Contains(lower, "timed out"),
+		strings.Contains(lower, "something went wrong"), strings.Contains(lower, "connection"):
+		return CategoryUpstreamUnavailable
+	default:
+		return CategoryValidation
+	}
+}
+
+// ToolError describes the GraphQL error for the structured content of a tool result.
+func (e *GitHubGraphQLError) ToolError() ToolError {
+	category := e.Category()
+	toolErr := ToolError{
+		Category:  category,
+		Message:   e.Error(),
+		Retryable: category.Retryable(),
+	}
+	var localErr *LocalRateLimitError
+	if errors.As(e.Err, &localErr) {
+		toolErr.RetryAfterSeconds = int(math.Ceil(localErr.RetryAfter.Seconds()))
+	}
+	return toolErr
+}
+
+// WithToolError attaches the structured error to a tool result.
+func WithToolError(result *mcp.CallToolResult, toolErr ToolError) *mcp.CallToolResult {
+	result.StructuredContent = map[string]any{"error": toolErr}
+	return result
+}
+
+// NewToolResultErrorWithCategory returns a tool error result carrying the given category,
+// for failures that do not come from a GitHub API call, such as invalid parameters.
+func NewToolResultErrorWithCategory(category Category, message string) *mcp.CallToolResult {
+	return WithToolError(mcp.NewToolResultError(message), ToolError{
+		Category:  category,
+		Message:   message,
+		Retryable: category.Retryable(),
+	})
+}
+
+// HasToolError reports whether the result already carries a structured error.
+func HasToolError(result *mcp.CallToolResult) bool {
+	content, ok := result.StructuredContent.(map[string]any)
+	if !ok {
+		return false
+	}
+	_, ok = content["error"].(ToolError)
+	return ok
+}
+
+// validationMessagePattern matches the messages of errors raised by the tools themselves for
+// invalid parameters, such as those of the parameter helpers in pkg/github.
+var validationMessagePattern = regexp.MustCompile(`(?i)missing required parameter|parameter \S+ (is not of type|could not be coerced)|\binvalid\b|\bmust\b|\brequired\b|\bcannot be\b|\bexceeds\b`)
+
+// collectedToolError returns the ToolError of the last GitHub error stored in the context.
+func collectedToolError(ctx context.Context) (ToolError, bool) {
+	if apiErrors, err := GetGitHubAPIErrors(ctx); err == nil && len(apiErrors) > 0 {
+		return apiErrors[len(apiErrors)-1].ToolError(), true
+	}
+	if gqlErrors, err := GetGitHubGraphQLErrors(ctx); err == nil && len(gqlErrors) > 0 {
+		return gqlErrors[len(gqlErrors)-1].ToolError(), true
+	}
+	return ToolError{}, false
+}
+
+// EnsureToolError attaches a ToolError to an error result that does not carry one yet, such
+// as results built with mcp.NewToolResultError. The category is taken from the last GitHub
+// error stored in the context. Without one, it is validation for parameter errors and
+// upstream_unavailable otherwise, since the cause of the failure is unknown.
+func EnsureToolError(ctx context.Context, result *mcp.CallToolResult) *mcp.CallToolResult {
+	if result == nil || !result.IsError || HasToolError(result) {
+		return result
+	}
+
+	var message string
+	for _, content := range result.Content {
+		if text, ok := content.(mcp.TextContent); ok {
+			message = text.Text
+			break
+		}
+	}
+
+	toolErr, ok := collectedToolError(ctx)
+	if !ok {
+		category := CategoryUpstreamUnavailable
+		if validationMessagePattern.MatchString(message) {
+			category = CategoryValidation
+		}
+		toolErr = ToolError{Category: category, Retryable: category.Retryable()}
+	}
+	if message != "" {
+		toolErr.Message = message
+	}
+	return WithToolError(result, toolErr)
+}
+
+// NewToolResultErrorFromError converts an error returned by a tool handler, such as a failure
+// to create the GitHub client, into a tool error result, so that it reaches the client with a
+// category and retry hints instead of as a bare JSON-RPC error. The category is taken from the
+// last GitHub error stored in the context, and is upstream_unavailable when there is none.
+func NewToolResultErrorFromError(ctx context.Context, err error) *mcp.CallToolResult {
+	toolErr, ok := collectedToolError(ctx)
+	var localErr *LocalRateLimitError
+	switch {
+	case errors.As(err, &localErr):
+		toolErr = ToolError{
+			Category:          CategoryRateLimited,
+			Retryable:         true,
+			RetryAfterSeconds: int(math.Ceil(localErr.RetryAfter.Seconds())),
+		}
+	case !ok:
+		toolErr = ToolError{
+			Category:  CategoryUpstreamUnavailable,
+			Retryable: CategoryUpstreamUnavailable.Retryable(),
+		}
+	}
+	toolErr.Message = err.Error()
+	return WithToolError(mcp.NewToolResultError(err.Error()), toolErr)
+}
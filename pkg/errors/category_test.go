@@ -0,0 +1,163 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func responseWithStatus(statusCode int) *github.Response {
+	return &github.Response{Response: &http.Response{StatusCode: statusCode, Header: http.Header{}}}
+}
+
+func TestGitHubAPIErrorCategory(t *testing.T) {
+	tests := []struct {
+		name     string
+		resp     *github.Response
+		err      error
+		expected Category
+	}{
+		{name: "404", resp: responseWithStatus(http.StatusNotFound), expected: CategoryNotFound},
+		{name: "401", resp: responseWithStatus(http.StatusUnauthorized), expected: CategoryForbidden},
+		{name: "403", resp: responseWithStatus(http.StatusForbidden), expected: CategoryForbidden},
+		{name: "409", resp: responseWithStatus(http.StatusConflict), expected: CategoryConflict},
+		{name: "422", resp: responseWithStatus(http.StatusUnprocessableEntity), expected: CategoryValidation},
+		{name: "429", resp: responseWithStatus(http.StatusTooManyRequests), expected: CategoryRateLimited},
+		{name: "502", resp: responseWithStatus(http.StatusBadGateway), expected: CategoryUpstreamUnavailable},
+		{name: "no response", resp: nil, expected: CategoryUpstreamUnavailable},
+		{
+			name: "403 with exhausted rate limit",
+			resp: &github.Response{
+				Response: &http.Response{StatusCode: http.StatusForbidden},
+				Rate:     github.Rate{Limit: 5000, Remaining: 0},
+			},
+			expected: CategoryRateLimited,
+		},
+		{
+			name:     "secondary rate limit error",
+			resp:     responseWithStatus(http.StatusForbidden),
+			err:      &github.AbuseRateLimitError{Message: "slow down"},
+			expected: CategoryRateLimited,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.err
+			if err == nil {
+				err = fmt.Errorf("request failed")
+			}
+			apiErr := newGitHubAPIError("failed", tc.resp, err)
+			assert.Equal(t, tc.expected, apiErr.Category())
+			assert.Equal(t, tc.expected.Retryable(), apiErr.ToolError().Retryable)
+		})
+	}
+}
+
+func TestGitHubAPIErrorRetryAfter(t *testing.T) {
+	t.Run("Retry-After header", func(t *testing.T) {
+		resp := responseWithStatus(http.StatusTooManyRequests)
+		resp.Header.Set("Retry-After", "30")
+		toolErr := newGitHubAPIError("failed", resp, fmt.Errorf("slow down")).ToolError()
+		assert.Equal(t, 30, toolErr.RetryAfterSeconds)
+		assert.True(t, toolErr.Retryable)
+	})
+
+	t.Run("secondary rate limit", func(t *testing.T) {
+		retryAfter := 90 * time.Second
+		err := &github.AbuseRateLimitError{RetryAfter: &retryAfter}
+		toolErr := newGitHubAPIError("failed", responseWithStatus(http.StatusForbidden), err).ToolError()
+		assert.Equal(t, 90, toolErr.RetryAfterSeconds)
+	})
+
+	t.Run("primary rate limit reset", func(t *testing.T) {
+		resp := &github.Response{
+			Response: &http.Response{StatusCode: http.StatusForbidden, Header: http.Header{}},
+			Rate:     github.Rate{Limit: 5000, Remaining: 0, Reset: github.Timestamp{Time: time.Now().Add(time.Minute)}},
+		}
+		toolErr := newGitHubAPIError("failed", resp, fmt.Errorf("rate limited")).ToolError()
+		assert.Equal(t, CategoryRateLimited, toolErr.Category)
+		assert.InDelta(t, 60, toolErr.RetryAfterSeconds, 2)
+	})
+
+	t.Run("not found has no retry hint", func(t *testing.T) {
+		toolErr := newGitHubAPIError("failed", responseWithStatus(http.StatusNotFound), fmt.Errorf("missing")).ToolError()
+		assert.Equal(t, ToolError{Category: CategoryNotFound, Message: "failed: missing", StatusCode: http.StatusNotFound}, toolErr)
+	})
+}
+
+func TestGitHubGraphQLErrorCategory(t *testing.T) {
+	tests := map[string]Category{
+		"Could not resolve to a Repository with the name 'owner/missing'.": CategoryNotFound,
+		"Resource not accessible by integration":                           CategoryForbidden,
+		"API rate limit exceeded for user ID 1.":                           CategoryRateLimited,
+		"non-200 OK status code: 502 Bad Gateway body: \"\"":               CategoryUpstreamUnavailable,
+		"non-200 OK status code: 401 Unauthorized body: \"\"":              CategoryForbidden,
+		"Argument 'first' on Field 'issues' has an invalid value":          CategoryValidation,
+	}
+	for message, expected := range tests {
+		t.Run(message, func(t *testing.T) {
+			assert.Equal(t, expected, newGitHubGraphQLError("failed", fmt.Errorf("%s", message)).Category())
+		})
+	}
+}
+
+func TestToolErrorResults(t *testing.T) {
+	t.Run("API error response carries structured error", func(t *testing.T) {
+		result := NewGitHubAPIErrorResponse(context.Background(), "failed to get issue", responseWithStatus(http.StatusNotFound), fmt.Errorf("Not Found"))
+		require.True(t, HasToolError(result))
+		toolErr := result.StructuredContent.(map[string]any)["error"].(ToolError)
+		assert.Equal(t, CategoryNotFound, toolErr.Category)
+		assert.Equal(t, http.StatusNotFound, toolErr.StatusCode)
+	})
+
+	t.Run("plain error result falls back to validation", func(t *testing.T) {
+		result := EnsureToolError(context.Background(), mcp.NewToolResultError("missing required parameter: owner"))
+		assert.Equal(t, ToolError{Category: CategoryValidation, Message: "missing required parameter: owner"}, result.StructuredContent.(map[string]any)["error"])
+	})
+
+	t.Run("plain error result with unknown cause is upstream_unavailable", func(t *testing.T) {
+		result := EnsureToolError(context.Background(), mcp.NewToolResultError("failed to read response body"))
+		assert.Equal(t, ToolError{Category: CategoryUpstreamUnavailable, Message: "failed to read response body", Retryable: true}, result.StructuredContent.(map[string]any)["error"])
+	})
+
+	t.Run("Go error becomes an error result", func(t *testing.T) {
+		result := NewToolResultErrorFromError(context.Background(), fmt.Errorf("failed to get GitHub client: %w", fmt.Errorf("no token")))
+		require.True(t, result.IsError)
+		assert.Equal(t, ToolError{Category: CategoryUpstreamUnavailable, Message: "failed to get GitHub client: no token", Retryable: true}, result.StructuredContent.(map[string]any)["error"])
+	})
+
+	t.Run("Go error from local rate limit", func(t *testing.T) {
+		err := fmt.Errorf("failed: %w", &LocalRateLimitError{Reason: "too many requests", RetryAfter: 1500 * time.Millisecond})
+		toolErr := NewToolResultErrorFromError(context.Background(), err).StructuredContent.(map[string]any)["error"].(ToolError)
+		assert.Equal(t, CategoryRateLimited, toolErr.Category)
+		assert.Equal(t, 2, toolErr.RetryAfterSeconds)
+	})
+
+	t.Run("plain error result uses collected GitHub error", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+		_, _ = NewGitHubAPIErrorToCtx(ctx, "failed to get issue", responseWithStatus(http.StatusConflict), fmt.Errorf("conflict"))
+		result := EnsureToolError(ctx, mcp.NewToolResultError("failed to update issue"))
+		toolErr := result.StructuredContent.(map[string]any)["error"].(ToolError)
+		assert.Equal(t, CategoryConflict, toolErr.Category)
+		assert.Equal(t, "failed to update issue", toolErr.Message)
+	})
+
+	t.Run("successful results are untouched", func(t *testing.T) {
+		result := EnsureToolError(context.Background(), mcp.NewToolResultText("ok"))
+		assert.Nil(t, result.StructuredContent)
+	})
+
+	t.Run("explicit category", func(t *testing.T) {
+		result := NewToolResultErrorWithCategory(CategoryConflict, "branch moved")
+		assert.True(t, result.IsError)
+		assert.True(t, HasToolError(result))
+	})
+}
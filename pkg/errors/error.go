@@ -3,6 +3,7 @@ package errors
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/go-github/v74/github"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -106,20 +107,92 @@ func addGitHubGraphQLErrorToContext(ctx context.Context, err *GitHubGraphQLError
 	return nil, fmt.Errorf("context does not contain GitHubCtxErrors")
 }
 
-// NewGitHubAPIErrorResponse returns an mcp.NewToolResultError and retains the error in the context for access via middleware
+// NewGitHubAPIErrorResponse returns an mcp.NewToolResultError carrying a structured ToolError and retains the error in the context for access via middleware
 func NewGitHubAPIErrorResponse(ctx context.Context, message string, resp *github.Response, err error) *mcp.CallToolResult {
 	apiErr := newGitHubAPIError(message, resp, err)
 	if ctx != nil {
 		_, _ = addGitHubAPIErrorToContext(ctx, apiErr) // Explicitly ignore error for graceful handling
 	}
-	return mcp.NewToolResultErrorFromErr(message, err)
+	return WithToolError(mcp.NewToolResultErrorFromErr(message, err), apiErr.ToolError())
 }
 
-// NewGitHubGraphQLErrorResponse returns an mcp.NewToolResultError and retains the error in the context for access via middleware
+// NewGitHubGraphQLErrorResponse returns an mcp.NewToolResultError carrying a structured ToolError and retains the error in the context for access via middleware
 func NewGitHubGraphQLErrorResponse(ctx context.Context, message string, err error) *mcp.CallToolResult {
 	graphQLErr := newGitHubGraphQLError(message, err)
 	if ctx != nil {
 		_, _ = addGitHubGraphQLErrorToContext(ctx, graphQLErr) // Explicitly ignore error for graceful handling
 	}
-	return mcp.NewToolResultErrorFromErr(message, err)
+	return WithToolError(mcp.NewToolResultErrorFromErr(message, err), graphQLErr.ToolError())
+}
+
+// ErrorSummary is a structured description of a GitHub error that is safe to log or
+// return to clients. It deliberately omits the wrapped error, which may contain user data.
+type ErrorSummary struct {
+	Type       string            `json:"type"`
+	Category   Category          `json:"category"`
+	Message    string            `json:"message"`
+	StatusCode int               `json:"status_code,omitempty"`
+	RequestID  string            `json:"request_id,omitempty"`
+	RateLimit  *RateLimitSummary `json:"rate_limit,omitempty"`
+}
+
+// RateLimitSummary holds the rate limit headers of a GitHub response.
+type RateLimitSummary struct {
+	Limit     int       `json:"limit"`
+	Remaining int       `json:"remaining"`
+	Reset     time.Time `json:"reset"`
+}
+
+const (
+	ErrorTypeAPI     = "api"
+	ErrorTypeGraphQL = "graphql"
+)
+
+// Summary describes the API error using the status code, request ID and rate limit
+// headers of the GitHub response, when there is one.
+func (e *GitHubAPIError) Summary() ErrorSummary {
+	summary := ErrorSummary{
+		Type:     ErrorTypeAPI,
+		Category: e.Category(),
+		Message:  e.Message,
+	}
+	if e.Response == nil || e.Response.Response == nil {
+		return summary
+	}
+	summary.StatusCode = e.Response.StatusCode
+	summary.RequestID = e.Response.Header.Get("X-GitHub-Request-Id")
+	if e.Response.Rate.Limit > 0 {
+		summary.RateLimit = &RateLimitSummary{
+			Limit:     e.Response.Rate.Limit,
+			Remaining: e.Response.Rate.Remaining,
+			Reset:     e.Response.Rate.Reset.Time,
+		}
+	}
+	return summary
+}
+
+// Summary describes the GraphQL error.
+func (e *GitHubGraphQLError) Summary() ErrorSummary {
+	return ErrorSummary{
+		Type:     ErrorTypeGraphQL,
+		Category: e.Category(),
+		Message:  e.Message,
+	}
+}
+
+// GetGitHubErrorSummaries returns a summary of every GitHub error stored in the context,
+// API errors first.
+func GetGitHubErrorSummaries(ctx context.Context) []ErrorSummary {
+	var summaries []ErrorSummary
+	if apiErrors, err := GetGitHubAPIErrors(ctx); err == nil {
+		for _, apiErr := range apiErrors {
+			summaries = append(summaries, apiErr.Summary())
+		}
+	}
+	if gqlErrors, err := GetGitHubGraphQLErrors(ctx); err == nil {
+		for _, gqlErr := range gqlErrors {
+			summaries = append(summaries, gqlErr.Summary())
+		}
+	}
+	return summaries
 }
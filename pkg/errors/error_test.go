@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-github/v74/github"
 	"github.com/stretchr/testify/assert"
@@ -377,3 +378,40 @@ func TestMiddlewareScenario(t *testing.T) {
 		assert.Contains(t, gqlMessages, "mutation failed")
 	})
 }
+
+func TestGitHubErrorSummaries(t *testing.T) {
+	t.Run("API error summary includes status, request ID and rate limit", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+		reset := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		resp := &github.Response{
+			Response: &http.Response{
+				StatusCode: http.StatusForbidden,
+				Header:     http.Header{"X-Github-Request-Id": []string{"ABCD:1234"}},
+			},
+			Rate: github.Rate{Limit: 5000, Remaining: 0, Reset: github.Timestamp{Time: reset}},
+		}
+		_ = NewGitHubAPIErrorResponse(ctx, "failed to get issue", resp, fmt.Errorf("secret detail"))
+		_ = NewGitHubGraphQLErrorResponse(ctx, "failed to run query", fmt.Errorf("another detail"))
+
+		summaries := GetGitHubErrorSummaries(ctx)
+		require.Len(t, summaries, 2)
+		assert.Equal(t, ErrorSummary{
+			Type:       ErrorTypeAPI,
+			Category:   CategoryRateLimited,
+			Message:    "failed to get issue",
+			StatusCode: http.StatusForbidden,
+			RequestID:  "ABCD:1234",
+			RateLimit:  &RateLimitSummary{Limit: 5000, Remaining: 0, Reset: reset},
+		}, summaries[0])
+		assert.Equal(t, ErrorSummary{Type: ErrorTypeGraphQL, Category: CategoryValidation, Message: "failed to run query"}, summaries[1])
+	})
+
+	t.Run("API error without response", func(t *testing.T) {
+		summary := newGitHubAPIError("failed", nil, fmt.Errorf("boom")).Summary()
+		assert.Equal(t, ErrorSummary{Type: ErrorTypeAPI, Category: CategoryUpstreamUnavailable, Message: "failed"}, summary)
+	})
+
+	t.Run("context without error tracking", func(t *testing.T) {
+		assert.Empty(t, GetGitHubErrorSummaries(context.Background()))
+	})
+}
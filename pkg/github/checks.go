@@ -0,0 +1,882 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-http/pkg/errors"
+	"github.com/github/github-mcp-http/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxCheckRunPages bounds the number of pages of check runs fetched for a pull request summary.
+const maxCheckRunPages = 10
+
+// checkRunAnnotationSchema describes an annotation accepted by create_check_run and update_check_run.
+var checkRunAnnotationSchema = map[string]any{
+	"type":                 "object",
+	"additionalProperties": false,
+	"required":             []string{"path", "start_line", "end_line", "annotation_level", "message"},
+	"properties": map[string]any{
+		"path": map[string]any{
+			"type":        "string",
+			"description": "Path of the file to annotate, relative to the repository root",
+		},
+		"start_line": map[string]any{
+			"type":        "number",
+			"description": "Start line of the annotation",
+		},
+		"end_line": map[string]any{
+			"type":        "number",
+			"description": "End line of the annotation",
+		},
+		"annotation_level": map[string]any{
+			"type":        "string",
+			"description": "Level of the annotation",
+			"enum":        []string{"notice", "warning", "failure"},
+		},
+		"message": map[string]any{
+			"type":        "string",
+			"description": "Short description of the feedback for these lines of code",
+		},
+		"title": map[string]any{
+			"type":        "string",
+			"description": "Title of the annotation",
+		},
+	},
+}
+
+// withCheckRef adds the ref and pullNumber parameters used to select the commit whose checks are listed.
+func withCheckRef() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("ref",
+			mcp.Description("Commit SHA, branch or tag name. Either ref or pullNumber is required"),
+		)(tool)
+		mcp.WithNumber("pullNumber",
+			mcp.Description("Pull request number, whose head commit is used. Either ref or pullNumber is required"),
+		)(tool)
+	}
+}
+
+// resolveCheckRef returns the ref given in the request, or the head SHA of the given pull request.
+func resolveCheckRef(ctx context.Context, client *github.Client, request mcp.CallToolRequest, owner, repo string) (string, *mcp.CallToolResult, error) {
+	ref, err := OptionalParam[string](request, "ref")
+	if err != nil {
+		return "", mcp.NewToolResultError(err.Error()), nil
+	}
+	pullNumber, err := OptionalIntParam(request, "pullNumber")
+	if err != nil {
+		return "", mcp.NewToolResultError(err.Error()), nil
+	}
+	switch {
+	case ref != "" && pullNumber != 0:
+		return "", mcp.NewToolResultError("only one of ref or pullNumber can be provided"), nil
+	case ref != "":
+		return ref, nil, nil
+	case pullNumber == 0:
+		return "", mcp.NewToolResultError("either ref or pullNumber is required"), nil
+	}
+
+	pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+	if err != nil {
+		return "", ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get pull request", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+	return pr.GetHead().GetSHA(), nil, nil
+}
+
+// ListCheckRuns creates a tool to list the check runs of a commit or pull request.
+func ListCheckRuns(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_check_runs",
+			mcp.WithDescription(t("TOOL_LIST_CHECK_RUNS_DESCRIPTION", "List the check runs reported through the Checks API for a commit, branch, tag or pull request. Includes GitHub Actions jobs and third-party CI apps.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_CHECK_RUNS_USER_TITLE", "List check runs"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			withCheckRef(),
+			mcp.WithString("check_name",
+				mcp.Description("Only return check runs with this name"),
+			),
+			mcp.WithString("status",
+				mcp.Description("Only return check runs with this status"),
+				mcp.Enum("queued", "in_progress", "completed"),
+			),
+			mcp.WithString("filter",
+				mcp.Description("Return only the latest check run of each name, or all check runs. Default: latest"),
+				mcp.Enum("latest", "all"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			checkName, err := OptionalParam[string](request, "check_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			status, err := OptionalParam[string](request, "status")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			filter, err := OptionalParam[string](request, "filter")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			ref, errResult, err := resolveCheckRef(ctx, client, request, owner, repo)
+			if errResult != nil || err != nil {
+				return errResult, err
+			}
+
+			opts := &github.ListCheckRunsOptions{
+				CheckName: ToStringPtr(checkName),
+				Status:    ToStringPtr(status),
+				Filter:    ToStringPtr(filter),
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			}
+			result, resp, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, ref, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list check runs", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			checkRuns := make([]MinimalCheckRun, 0, len(result.CheckRuns))
+			for _, checkRun := range result.CheckRuns {
+				checkRuns = append(checkRuns, convertToMinimalCheckRun(checkRun, false))
+			}
+			return MarshalledTextResult(MinimalCheckRunsResult{
+				TotalCount: result.GetTotal(),
+				Ref:        ref,
+				CheckRuns:  checkRuns,
+			}), nil
+		}
+}
+
+// ListCheckSuites creates a tool to list the check suites of a commit or pull request.
+func ListCheckSuites(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_check_suites",
+			mcp.WithDescription(t("TOOL_LIST_CHECK_SUITES_DESCRIPTION", "List the check suites for a commit, branch, tag or pull request. A check suite groups the check runs created by one GitHub App.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_CHECK_SUITES_USER_TITLE", "List check suites"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			withCheckRef(),
+			mcp.WithString("check_name",
+				mcp.Description("Only return check suites containing a check run with this name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			checkName, err := OptionalParam[string](request, "check_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			ref, errResult, err := resolveCheckRef(ctx, client, request, owner, repo)
+			if errResult != nil || err != nil {
+				return errResult, err
+			}
+
+			opts := &github.ListCheckSuiteOptions{
+				CheckName: ToStringPtr(checkName),
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			}
+			result, resp, err := client.Checks.ListCheckSuitesForRef(ctx, owner, repo, ref, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list check suites", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			checkSuites := make([]MinimalCheckSuite, 0, len(result.CheckSuites))
+			for _, checkSuite := range result.CheckSuites {
+				checkSuites = append(checkSuites, convertToMinimalCheckSuite(checkSuite))
+			}
+			return MarshalledTextResult(MinimalCheckSuitesResult{
+				TotalCount:  result.GetTotal(),
+				Ref:         ref,
+				CheckSuites: checkSuites,
+			}), nil
+		}
+}
+
+// GetCheckRun creates a tool to get a check run including its output.
+func GetCheckRun(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_check_run",
+			mcp.WithDescription(t("TOOL_GET_CHECK_RUN_DESCRIPTION", "Get a check run, including its output title, summary and text. Use list_check_run_annotations for the annotations.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_CHECK_RUN_USER_TITLE", "Get check run"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("check_run_id",
+				mcp.Required(),
+				mcp.Description("The unique identifier of the check run"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			checkRunID, err := RequiredInt(request, "check_run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			checkRun, resp, err := client.Checks.GetCheckRun(ctx, owner, repo, int64(checkRunID))
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get check run", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(convertToMinimalCheckRun(checkRun, true)), nil
+		}
+}
+
+// ListCheckRunAnnotations creates a tool to list the annotations of a check run.
+func ListCheckRunAnnotations(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_check_run_annotations",
+			mcp.WithDescription(t("TOOL_LIST_CHECK_RUN_ANNOTATIONS_DESCRIPTION", "List the annotations of a check run, such as compiler errors, test failures and lint warnings, with their file, lines, level and message.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_CHECK_RUN_ANNOTATIONS_USER_TITLE", "List check run annotations"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("check_run_id",
+				mcp.Required(),
+				mcp.Description("The unique identifier of the check run"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			checkRunID, err := RequiredInt(request, "check_run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			annotations, resp, err := client.Checks.ListCheckRunAnnotations(ctx, owner, repo, int64(checkRunID), &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list check run annotations", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(annotations), nil
+		}
+}
+
+// GetPullRequestCheckSummary creates a tool that rolls up the check runs and commit statuses
+// of a pull request into a single readiness summary.
+func GetPullRequestCheckSummary(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pull_request_check_summary",
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_CHECK_SUMMARY_DESCRIPTION", "Get a rolled-up readiness summary of a pull request, combining the Checks API check runs and the commit statuses of its head commit with its draft and mergeable state. Lists the failing and pending checks by name. truncated is set when the pull request has more checks than can be read, in which case the state is never success.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PULL_REQUEST_CHECK_SUMMARY_USER_TITLE", "Get pull request check summary"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get pull request", resp, err), nil
+			}
+			_ = resp.Body.Close()
+			headSHA := pr.GetHead().GetSHA()
+
+			summary := PullRequestCheckSummary{
+				HeadSHA:        headSHA,
+				Draft:          pr.GetDraft(),
+				Mergeable:      pr.Mergeable,
+				MergeableState: pr.GetMergeableState(),
+				FailingChecks:  []string{},
+				PendingChecks:  []string{},
+			}
+
+			checks, truncated, errResult := listCheckResults(ctx, client, owner, repo, headSHA)
+			if errResult != nil {
+				return errResult, nil
+			}
+			summary.Truncated = truncated
+			for _, check := range checks {
+				summary.addCheck(check.name, check.state)
+			}
+
+			summary.finish()
+			return MarshalledTextResult(summary), nil
+		}
+}
+
+// CreateCheckRun creates a tool to create a check run. Only GitHub Apps can create check runs.
+func CreateCheckRun(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("create_check_run",
+			mcp.WithDescription(t("TOOL_CREATE_CHECK_RUN_DESCRIPTION", "Create a check run for a commit. Requires a GitHub App installation token with the checks:write permission.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_CHECK_RUN_USER_TITLE", "Create check run"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Name of the check run"),
+			),
+			mcp.WithString("head_sha",
+				mcp.Required(),
+				mcp.Description("SHA of the commit to report the check run on"),
+			),
+			withCheckRunFields(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := RequiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			headSHA, err := RequiredParam[string](request, "head_sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fields, err := optionalCheckRunFields(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			checkRun, resp, err := client.Checks.CreateCheckRun(ctx, owner, repo, github.CreateCheckRunOptions{
+				Name:        name,
+				HeadSHA:     headSHA,
+				DetailsURL:  fields.detailsURL,
+				ExternalID:  fields.externalID,
+				Status:      fields.status,
+				Conclusion:  fields.conclusion,
+				CompletedAt: fields.completedAt,
+				Output:      fields.output,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create check run", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(convertToMinimalCheckRun(checkRun, false)), nil
+		}
+}
+
+// UpdateCheckRun creates a tool to update a check run. Only GitHub Apps can update check runs.
+func UpdateCheckRun(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("update_check_run",
+			mcp.WithDescription(t("TOOL_UPDATE_CHECK_RUN_DESCRIPTION", "Update the status, conclusion or output of a check run, for example to complete it. Annotations are added to the existing ones. Requires a GitHub App installation token with the checks:write permission.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_CHECK_RUN_USER_TITLE", "Update check run"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("check_run_id",
+				mcp.Required(),
+				mcp.Description("The unique identifier of the check run"),
+			),
+			mcp.WithString("name",
+				mcp.Description("New name of the check run. Keeps the current name when omitted"),
+			),
+			withCheckRunFields(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			checkRunID, err := RequiredInt(request, "check_run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := OptionalParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fields, err := optionalCheckRunFields(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// The API requires the name on every update, so look up the current one when it is not changed.
+			if name == "" {
+				existing, resp, err := client.Checks.GetCheckRun(ctx, owner, repo, int64(checkRunID))
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get check run", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				name = existing.GetName()
+			}
+
+			checkRun, resp, err := client.Checks.UpdateCheckRun(ctx, owner, repo, int64(checkRunID), github.UpdateCheckRunOptions{
+				Name:        name,
+				DetailsURL:  fields.detailsURL,
+				ExternalID:  fields.externalID,
+				Status:      fields.status,
+				Conclusion:  fields.conclusion,
+				CompletedAt: fields.completedAt,
+				Output:      fields.output,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to update check run", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(convertToMinimalCheckRun(checkRun, false)), nil
+		}
+}
+
+// RerequestCheckSuite creates a tool to rerequest a check suite.
+func RerequestCheckSuite(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("rerequest_check_suite",
+			mcp.WithDescription(t("TOOL_REREQUEST_CHECK_SUITE_DESCRIPTION", "Rerequest a check suite, so the GitHub App that owns it runs its checks again on the same commit.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_REREQUEST_CHECK_SUITE_USER_TITLE", "Rerequest check suite"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("check_suite_id",
+				mcp.Required(),
+				mcp.Description("The unique identifier of the check suite"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			checkSuiteID, err := RequiredInt(request, "check_suite_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			resp, err := client.Checks.ReRequestCheckSuite(ctx, owner, repo, int64(checkSuiteID))
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to rerequest check suite", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(map[string]any{
+				"message":        "Check suite has been rerequested",
+				"check_suite_id": checkSuiteID,
+			}), nil
+		}
+}
+
+// withCheckRunFields adds the optional parameters shared by create_check_run and update_check_run.
+func withCheckRunFields() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("status",
+			mcp.Description("Current status of the check run"),
+			mcp.Enum("queued", "in_progress", "completed"),
+		)(tool)
+		mcp.WithString("conclusion",
+			mcp.Description("Final conclusion of the check run. Setting it marks the check run as completed"),
+			mcp.Enum("action_required", "cancelled", "failure", "neutral", "success", "skipped", "timed_out"),
+		)(tool)
+		mcp.WithString("details_url",
+			mcp.Description("URL of the integrator's site with the full details of the check"),
+		)(tool)
+		mcp.WithString("external_id",
+			mcp.Description("Reference for the run on the integrator's system"),
+		)(tool)
+		mcp.WithString("title",
+			mcp.Description("Title of the check run output. Required when summary or annotations are provided"),
+		)(tool)
+		mcp.WithString("summary",
+			mcp.Description("Summary of the check run output, in Markdown. Required when title or annotations are provided"),
+		)(tool)
+		mcp.WithString("text",
+			mcp.Description("Details of the check run output, in Markdown"),
+		)(tool)
+		mcp.WithArray("annotations",
+			mcp.Description("Annotations to add to the check run, at most 50 per request"),
+			mcp.Items(checkRunAnnotationSchema),
+		)(tool)
+	}
+}
+
+// checkRunFields holds the optional parameters shared by create_check_run and update_check_run.
+type checkRunFields struct {
+	status      *string
+	conclusion  *string
+	completedAt *github.Timestamp
+	detailsURL  *string
+	externalID  *string
+	output      *github.CheckRunOutput
+}
+
+func optionalCheckRunFields(request mcp.CallToolRequest) (checkRunFields, error) {
+	var fields checkRunFields
+	values := make(map[string]string)
+	for _, name := range []string{"status", "conclusion", "details_url", "external_id", "title", "summary", "text"} {
+		value, err := OptionalParam[string](request, name)
+		if err != nil {
+			return checkRunFields{}, err
+		}
+		values[name] = value
+	}
+	fields.status = ToStringPtr(values["status"])
+	fields.conclusion = ToStringPtr(values["conclusion"])
+	fields.detailsURL = ToStringPtr(values["details_url"])
+	fields.externalID = ToStringPtr(values["external_id"])
+	if fields.conclusion != nil {
+		fields.completedAt = &github.Timestamp{Time: time.Now()}
+	}
+
+	annotations, err := checkRunAnnotationsParam(request)
+	if err != nil {
+		return checkRunFields{}, err
+	}
+	title, summary, text := values["title"], values["summary"], values["text"]
+	if title == "" && summary == "" && text == "" && len(annotations) == 0 {
+		return fields, nil
+	}
+	if title == "" || summary == "" {
+		return checkRunFields{}, fmt.Errorf("title and summary are required when the check run output or annotations are provided")
+	}
+	fields.output = &github.CheckRunOutput{
+		Title:       github.Ptr(title),
+		Summary:     github.Ptr(summary),
+		Text:        ToStringPtr(text),
+		Annotations: annotations,
+	}
+	return fields, nil
+}
+
+func checkRunAnnotationsParam(request mcp.CallToolRequest) ([]*github.CheckRunAnnotation, error) {
+	raw, ok := request.GetArguments()["annotations"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	items, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("annotations must be an array of objects")
+	}
+
+	annotations := make([]*github.CheckRunAnnotation, 0, len(items))
+	for i, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("annotations[%d] must be an object", i)
+		}
+		path, _ := obj["path"].(string)
+		level, _ := obj["annotation_level"].(string)
+		message, _ := obj["message"].(string)
+		startLine, startOK := obj["start_line"].(float64)
+		endLine, endOK := obj["end_line"].(float64)
+		if path == "" || level == "" || message == "" || !startOK || !endOK {
+			return nil, fmt.Errorf("annotations[%d] requires path, start_line, end_line, annotation_level and message", i)
+		}
+		annotation := &github.CheckRunAnnotation{
+			Path:            github.Ptr(path),
+			StartLine:       github.Ptr(int(startLine)),
+			EndLine:         github.Ptr(int(endLine)),
+			AnnotationLevel: github.Ptr(level),
+			Message:         github.Ptr(message),
+		}
+		if title, ok := obj["title"].(string); ok && title != "" {
+			annotation.Title = github.Ptr(title)
+		}
+		annotations = append(annotations, annotation)
+	}
+	return annotations, nil
+}
+
+// checkResult is the summary state of a single check run or commit status.
+type checkResult struct {
+	name  string
+	state string
+}
+
+// listCheckResults returns the state of every check run and commit status reported on a commit.
+// truncated is set when there were more check runs or statuses than could be read.
+func listCheckResults(ctx context.Context, client *github.Client, owner, repo, sha string) (checks []checkResult, truncated bool, errResult *mcp.CallToolResult) {
+	opts := &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for page := 0; page < maxCheckRunPages; page++ {
+		result, resp, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, sha, opts)
+		if err != nil {
+			return nil, false, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list check runs", resp, err)
+		}
+		_ = resp.Body.Close()
+		for _, checkRun := range result.CheckRuns {
+			checks = append(checks, checkResult{name: checkRun.GetName(), state: checkRunState(checkRun)})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		if page == maxCheckRunPages-1 {
+			truncated = true
+		}
+		opts.Page = resp.NextPage
+	}
+
+	status, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, sha, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return nil, false, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get combined status", resp, err)
+	}
+	_ = resp.Body.Close()
+	for _, repoStatus := range status.Statuses {
+		checks = append(checks, checkResult{name: repoStatus.GetContext(), state: commitStatusState(repoStatus.GetState())})
+	}
+	if resp.NextPage != 0 {
+		truncated = true
+	}
+	return checks, truncated, nil
+}
+
+// Check states used in the pull request check summary.
+const (
+	checkStatePassing = "passing"
+	checkStateFailing = "failing"
+	checkStatePending = "pending"
+)
+
+// checkRunState maps the status and conclusion of a check run to a summary state.
+func checkRunState(checkRun *github.CheckRun) string {
+	if checkRun.GetStatus() != "completed" {
+		return checkStatePending
+	}
+	switch checkRun.GetConclusion() {
+	case "success", "neutral", "skipped":
+		return checkStatePassing
+	case "stale":
+		// GitHub marks a check run stale when it stayed incomplete for too long. It has
+		// not failed, but has to be re-run before it can pass.
+		return checkStatePending
+	default:
+		return checkStateFailing
+	}
+}
+
+// commitStatusState maps the state of a commit status to a summary state.
+func commitStatusState(state string) string {
+	switch state {
+	case "success":
+		return checkStatePassing
+	case "pending":
+		return checkStatePending
+	default:
+		return checkStateFailing
+	}
+}
+
+func (s *PullRequestCheckSummary) addCheck(name, state string) {
+	s.TotalCount++
+	switch state {
+	case checkStatePassing:
+		s.PassingCount++
+	case checkStatePending:
+		s.PendingChecks = append(s.PendingChecks, name)
+	default:
+		s.FailingChecks = append(s.FailingChecks, name)
+	}
+}
+
+// finish computes the overall state and readiness once every check has been added.
+func (s *PullRequestCheckSummary) finish() {
+	switch {
+	case len(s.FailingChecks) > 0:
+		s.State = "failure"
+	case len(s.PendingChecks) > 0:
+		s.State = "pending"
+	case s.Truncated:
+		// The checks that could not be read may be failing or pending.
+		s.State = "incomplete"
+	case s.TotalCount == 0:
+		s.State = "no_checks"
+	default:
+		s.State = "success"
+	}
+
+	s.Blockers = []string{}
+	if s.Draft {
+		s.Blockers = append(s.Blockers, "pull request is a draft")
+	}
+	if len(s.FailingChecks) > 0 {
+		s.Blockers = append(s.Blockers, fmt.Sprintf("%d failing checks", len(s.FailingChecks)))
+	}
+	if len(s.PendingChecks) > 0 {
+		s.Blockers = append(s.Blockers, fmt.Sprintf("%d pending checks", len(s.PendingChecks)))
+	}
+	if s.Truncated {
+		s.Blockers = append(s.Blockers, "not all checks could be read, so the state of the others is unknown")
+	}
+	if s.Mergeable != nil && !*s.Mergeable {
+		s.Blockers = append(s.Blockers, "pull request has merge conflicts")
+	}
+	if s.MergeableState == "blocked" {
+		s.Blockers = append(s.Blockers, "merging is blocked by branch protection, for example by required reviews")
+	}
+	s.Ready = len(s.Blockers) == 0
+}
@@ -0,0 +1,736 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-http/internal/toolsnaps"
+	"github.com/github/github-mcp-http/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListCheckRuns(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListCheckRuns(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_check_runs", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "check_name")
+	assert.Contains(t, tool.InputSchema.Properties, "status")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	checkRuns := &github.ListCheckRunsResults{
+		Total: github.Ptr(2),
+		CheckRuns: []*github.CheckRun{
+			{
+				ID:         github.Ptr(int64(1)),
+				Name:       github.Ptr("build"),
+				HeadSHA:    github.Ptr("abc123"),
+				Status:     github.Ptr("completed"),
+				Conclusion: github.Ptr("failure"),
+				App:        &github.App{Slug: github.Ptr("github-actions")},
+				Output: &github.CheckRunOutput{
+					Title:            github.Ptr("2 errors"),
+					Summary:          github.Ptr("Build failed"),
+					AnnotationsCount: github.Ptr(2),
+				},
+			},
+			{
+				ID:      github.Ptr(int64(2)),
+				Name:    github.Ptr("lint"),
+				HeadSHA: github.Ptr("abc123"),
+				Status:  github.Ptr("in_progress"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "list check runs for a ref",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+					expect(t, expectations{
+						path: "/repos/owner/repo/commits/main/check-runs",
+						queryParams: map[string]string{
+							"status":   "completed",
+							"page":     "1",
+							"per_page": "30",
+						},
+					}).andThen(mockResponse(t, http.StatusOK, checkRuns)),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"ref":    "main",
+				"status": "completed",
+			},
+		},
+		{
+			name: "list check runs for a pull request",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					&github.PullRequest{Head: &github.PullRequestBranch{SHA: github.Ptr("abc123")}},
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+					expectPath(t, "/repos/owner/repo/commits/abc123/check-runs").andThen(
+						mockResponse(t, http.StatusOK, checkRuns),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+		},
+		{
+			name:         "missing ref and pull request",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "either ref or pullNumber is required",
+		},
+		{
+			name:         "both ref and pull request",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"ref":        "main",
+				"pullNumber": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "only one of ref or pullNumber can be provided",
+		},
+		{
+			name: "ref not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list check runs",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListCheckRuns(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+			var returned MinimalCheckRunsResult
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, 2, returned.TotalCount)
+			require.Len(t, returned.CheckRuns, 2)
+			assert.Equal(t, "build", returned.CheckRuns[0].Name)
+			assert.Equal(t, "failure", returned.CheckRuns[0].Conclusion)
+			assert.Equal(t, "github-actions", returned.CheckRuns[0].App)
+			assert.Equal(t, 2, returned.CheckRuns[0].Output.AnnotationsCount)
+			assert.Empty(t, returned.CheckRuns[0].Output.Summary)
+			assert.Equal(t, "in_progress", returned.CheckRuns[1].Status)
+		})
+	}
+}
+
+func Test_ListCheckSuites(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListCheckSuites(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_check_suites", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposCommitsCheckSuitesByOwnerByRepoByRef,
+			expectPath(t, "/repos/owner/repo/commits/abc123/check-suites").andThen(
+				mockResponse(t, http.StatusOK, &github.ListCheckSuiteResults{
+					Total: github.Ptr(1),
+					CheckSuites: []*github.CheckSuite{
+						{
+							ID:         github.Ptr(int64(10)),
+							HeadBranch: github.Ptr("feature"),
+							HeadSHA:    github.Ptr("abc123"),
+							Status:     github.Ptr("completed"),
+							Conclusion: github.Ptr("success"),
+							App:        &github.App{Slug: github.Ptr("circleci")},
+						},
+					},
+				}),
+			),
+		),
+	))
+	_, handler := ListCheckSuites(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner": "owner",
+		"repo":  "repo",
+		"ref":   "abc123",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned MinimalCheckSuitesResult
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	require.Len(t, returned.CheckSuites, 1)
+	assert.Equal(t, MinimalCheckSuite{
+		ID:         10,
+		App:        "circleci",
+		HeadBranch: "feature",
+		HeadSHA:    "abc123",
+		Status:     "completed",
+		Conclusion: "success",
+	}, returned.CheckSuites[0])
+}
+
+func Test_GetCheckRun(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCheckRun(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_check_run", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "check_run_id"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful check run fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCheckRunsByOwnerByRepoByCheckRunId,
+					&github.CheckRun{
+						ID:         github.Ptr(int64(7)),
+						Name:       github.Ptr("test"),
+						Status:     github.Ptr("completed"),
+						Conclusion: github.Ptr("failure"),
+						Output: &github.CheckRunOutput{
+							Title:   github.Ptr("1 test failed"),
+							Summary: github.Ptr("TestFoo failed"),
+							Text:    github.Ptr("expected 1, got 2"),
+						},
+					},
+				),
+			),
+		},
+		{
+			name: "check run not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCheckRunsByOwnerByRepoByCheckRunId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get check run",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetCheckRun(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"check_run_id": float64(7),
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned MinimalCheckRun
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, int64(7), returned.ID)
+			assert.Equal(t, "TestFoo failed", returned.Output.Summary)
+			assert.Equal(t, "expected 1, got 2", returned.Output.Text)
+		})
+	}
+}
+
+func Test_ListCheckRunAnnotations(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListCheckRunAnnotations(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_check_run_annotations", tool.Name)
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "check_run_id"})
+
+	annotations := []*github.CheckRunAnnotation{
+		{
+			Path:            github.Ptr("main.go"),
+			StartLine:       github.Ptr(10),
+			EndLine:         github.Ptr(10),
+			AnnotationLevel: github.Ptr("failure"),
+			Message:         github.Ptr("undefined: foo"),
+		},
+	}
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposCheckRunsAnnotationsByOwnerByRepoByCheckRunId,
+			expect(t, expectations{
+				path:        "/repos/owner/repo/check-runs/7/annotations",
+				queryParams: map[string]string{"page": "2", "per_page": "50"},
+			}).andThen(mockResponse(t, http.StatusOK, annotations)),
+		),
+	))
+	_, handler := ListCheckRunAnnotations(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":        "owner",
+		"repo":         "repo",
+		"check_run_id": float64(7),
+		"page":         float64(2),
+		"perPage":      float64(50),
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned []*github.CheckRunAnnotation
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, annotations, returned)
+}
+
+func Test_GetPullRequestCheckSummary(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPullRequestCheckSummary(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_pull_request_check_summary", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	pr := &github.PullRequest{
+		Draft:          github.Ptr(false),
+		Mergeable:      github.Ptr(true),
+		MergeableState: github.Ptr("unstable"),
+		Head:           &github.PullRequestBranch{SHA: github.Ptr("abc123")},
+	}
+
+	tests := []struct {
+		name      string
+		checkRuns []*github.CheckRun
+		// moreCheckRuns makes every page of check runs link to a next page.
+		moreCheckRuns   bool
+		statuses        []*github.RepoStatus
+		expectedSummary PullRequestCheckSummary
+	}{
+		{
+			name: "failing check run is reported although the combined status is pending",
+			checkRuns: []*github.CheckRun{
+				{Name: github.Ptr("build"), Status: github.Ptr("completed"), Conclusion: github.Ptr("success")},
+				{Name: github.Ptr("test"), Status: github.Ptr("completed"), Conclusion: github.Ptr("failure")},
+				{Name: github.Ptr("lint"), Status: github.Ptr("queued")},
+			},
+			statuses: []*github.RepoStatus{
+				{Context: github.Ptr("ci/legacy"), State: github.Ptr("success")},
+			},
+			expectedSummary: PullRequestCheckSummary{
+				HeadSHA:        "abc123",
+				State:          "failure",
+				Ready:          false,
+				Blockers:       []string{"1 failing checks", "1 pending checks"},
+				Mergeable:      github.Ptr(true),
+				MergeableState: "unstable",
+				TotalCount:     4,
+				PassingCount:   2,
+				FailingChecks:  []string{"test"},
+				PendingChecks:  []string{"lint"},
+			},
+		},
+		{
+			name: "all checks passing",
+			checkRuns: []*github.CheckRun{
+				{Name: github.Ptr("build"), Status: github.Ptr("completed"), Conclusion: github.Ptr("success")},
+				{Name: github.Ptr("docs"), Status: github.Ptr("completed"), Conclusion: github.Ptr("skipped")},
+			},
+			expectedSummary: PullRequestCheckSummary{
+				HeadSHA:        "abc123",
+				State:          "success",
+				Ready:          true,
+				Blockers:       []string{},
+				Mergeable:      github.Ptr(true),
+				MergeableState: "unstable",
+				TotalCount:     2,
+				PassingCount:   2,
+				FailingChecks:  []string{},
+				PendingChecks:  []string{},
+			},
+		},
+		{
+			name: "stale check run is pending",
+			checkRuns: []*github.CheckRun{
+				{Name: github.Ptr("build"), Status: github.Ptr("completed"), Conclusion: github.Ptr("success")},
+				{Name: github.Ptr("e2e"), Status: github.Ptr("completed"), Conclusion: github.Ptr("stale")},
+			},
+			expectedSummary: PullRequestCheckSummary{
+				HeadSHA:        "abc123",
+				State:          "pending",
+				Ready:          false,
+				Blockers:       []string{"1 pending checks"},
+				Mergeable:      github.Ptr(true),
+				MergeableState: "unstable",
+				TotalCount:     2,
+				PassingCount:   1,
+				FailingChecks:  []string{},
+				PendingChecks:  []string{"e2e"},
+			},
+		},
+		{
+			name: "more check runs than can be read",
+			checkRuns: []*github.CheckRun{
+				{Name: github.Ptr("build"), Status: github.Ptr("completed"), Conclusion: github.Ptr("success")},
+			},
+			moreCheckRuns: true,
+			expectedSummary: PullRequestCheckSummary{
+				HeadSHA:        "abc123",
+				State:          "incomplete",
+				Ready:          false,
+				Blockers:       []string{"not all checks could be read, so the state of the others is unknown"},
+				Mergeable:      github.Ptr(true),
+				MergeableState: "unstable",
+				TotalCount:     maxCheckRunPages,
+				PassingCount:   maxCheckRunPages,
+				FailingChecks:  []string{},
+				PendingChecks:  []string{},
+				Truncated:      true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			checkRuns := &github.ListCheckRunsResults{Total: github.Ptr(len(tc.checkRuns)), CheckRuns: tc.checkRuns}
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, pr),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if tc.moreCheckRuns {
+							w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com%s?page=999>; rel="next"`, r.URL.Path))
+						}
+						mockResponse(t, http.StatusOK, checkRuns)(w, r)
+					}),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
+					&github.CombinedStatus{State: github.Ptr("pending"), Statuses: tc.statuses},
+				),
+			))
+			_, handler := GetPullRequestCheckSummary(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			}))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var returned PullRequestCheckSummary
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expectedSummary, returned)
+		})
+	}
+}
+
+func Test_CreateCheckRun(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateCheckRun(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_check_run", tool.Name)
+	assert.Contains(t, tool.InputSchema.Properties, "annotations")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "name", "head_sha"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "create check run with annotations",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposCheckRunsByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"name":     "lint",
+						"head_sha": "abc123",
+						"status":   "in_progress",
+						"output": map[string]any{
+							"title":   "1 warning",
+							"summary": "Lint found issues",
+							"annotations": []any{
+								map[string]any{
+									"path":             "main.go",
+									"start_line":       float64(3),
+									"end_line":         float64(4),
+									"annotation_level": "warning",
+									"message":          "unused variable",
+								},
+							},
+						},
+					}).andThen(mockResponse(t, http.StatusCreated, &github.CheckRun{
+						ID:      github.Ptr(int64(99)),
+						Name:    github.Ptr("lint"),
+						HeadSHA: github.Ptr("abc123"),
+						Status:  github.Ptr("in_progress"),
+					})),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"name":     "lint",
+				"head_sha": "abc123",
+				"status":   "in_progress",
+				"title":    "1 warning",
+				"summary":  "Lint found issues",
+				"annotations": []any{
+					map[string]any{
+						"path":             "main.go",
+						"start_line":       float64(3),
+						"end_line":         float64(4),
+						"annotation_level": "warning",
+						"message":          "unused variable",
+					},
+				},
+			},
+		},
+		{
+			name:         "annotations without output summary",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"name":     "lint",
+				"head_sha": "abc123",
+				"title":    "1 warning",
+			},
+			expectError:    true,
+			expectedErrMsg: "title and summary are required",
+		},
+		{
+			name:         "incomplete annotation",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"name":        "lint",
+				"head_sha":    "abc123",
+				"title":       "1 warning",
+				"summary":     "Lint found issues",
+				"annotations": []any{map[string]any{"path": "main.go"}},
+			},
+			expectError:    true,
+			expectedErrMsg: "annotations[0] requires path",
+		},
+		{
+			name: "not a GitHub App",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposCheckRunsByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, `{"message": "You must authenticate via a GitHub App."}`),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"name":     "lint",
+				"head_sha": "abc123",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create check run",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateCheckRun(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var returned MinimalCheckRun
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, int64(99), returned.ID)
+		})
+	}
+}
+
+func Test_UpdateCheckRun(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateCheckRun(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_check_run", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "check_run_id"})
+
+	var patched map[string]any
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposCheckRunsByOwnerByRepoByCheckRunId,
+			&github.CheckRun{ID: github.Ptr(int64(7)), Name: github.Ptr("lint")},
+		),
+		mock.WithRequestMatchHandler(
+			mock.PatchReposCheckRunsByOwnerByRepoByCheckRunId,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&patched))
+				mockResponse(t, http.StatusOK, &github.CheckRun{
+					ID:         github.Ptr(int64(7)),
+					Name:       github.Ptr("lint"),
+					Status:     github.Ptr("completed"),
+					Conclusion: github.Ptr("success"),
+				})(w, r)
+			}),
+		),
+	))
+	_, handler := UpdateCheckRun(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":        "owner",
+		"repo":         "repo",
+		"check_run_id": float64(7),
+		"conclusion":   "success",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	// The current name is looked up, and completion time is set with the conclusion.
+	assert.Equal(t, "lint", patched["name"])
+	assert.Equal(t, "success", patched["conclusion"])
+	assert.NotEmpty(t, patched["completed_at"])
+
+	var returned MinimalCheckRun
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, "success", returned.Conclusion)
+}
+
+func Test_RerequestCheckSuite(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RerequestCheckSuite(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "rerequest_check_suite", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "check_suite_id"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful rerequest",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposCheckSuitesRerequestByOwnerByRepoByCheckSuiteId,
+					expectPath(t, "/repos/owner/repo/check-suites/10/rerequest").andThen(
+						mockResponse(t, http.StatusCreated, ""),
+					),
+				),
+			),
+		},
+		{
+			name: "rerequest fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposCheckSuitesRerequestByOwnerByRepoByCheckSuiteId,
+					mockResponse(t, http.StatusForbidden, `{"message": "Forbidden"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to rerequest check suite",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RerequestCheckSuite(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"check_suite_id": float64(10),
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, "Check suite has been rerequested")
+		})
+	}
+}
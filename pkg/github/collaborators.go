@@ -0,0 +1,408 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-http/pkg/errors"
+	"github.com/github/github-mcp-http/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ListRepositoryCollaborators creates a tool to list the collaborators of a repository with their permissions.
+func ListRepositoryCollaborators(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_collaborators",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_COLLABORATORS_DESCRIPTION", "List the users with access to a repository and their permission level, including organization members with access through teams or base permissions. Use the permission filter to answer questions like who can push to a repository.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REPOSITORY_COLLABORATORS_USER_TITLE", "List repository collaborators"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("affiliation",
+				mcp.Description("Filter by affiliation: outside collaborators of an organization, direct collaborators regardless of organization membership, or all (default: all)"),
+				mcp.Enum("outside", "direct", "all"),
+			),
+			mcp.WithString("permission",
+				mcp.Description("Only list collaborators with at least this permission"),
+				mcp.Enum("pull", "triage", "push", "maintain", "admin"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			affiliation, err := OptionalParam[string](request, "affiliation")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			permission, err := OptionalParam[string](request, "permission")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			collaborators, resp, err := client.Repositories.ListCollaborators(ctx, owner, repo, &github.ListCollaboratorsOptions{
+				Affiliation: affiliation,
+				Permission:  permission,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list collaborators", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			minimalCollaborators := make([]MinimalCollaborator, len(collaborators))
+			for i, collaborator := range collaborators {
+				minimalCollaborators[i] = convertToMinimalCollaborator(collaborator)
+			}
+			return MarshalledTextResult(minimalCollaborators), nil
+		}
+}
+
+// ListRepositoryTeams creates a tool to list the teams with access to a repository.
+func ListRepositoryTeams(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_teams",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_TEAMS_DESCRIPTION", "List the teams with access to an organization repository and their permission level. Use get_team_members to see who is in a team.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REPOSITORY_TEAMS_USER_TITLE", "List repository teams"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			teams, resp, err := client.Repositories.ListTeams(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list repository teams", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			minimalTeams := make([]MinimalRepositoryTeam, len(teams))
+			for i, team := range teams {
+				minimalTeams[i] = convertToMinimalRepositoryTeam(team)
+			}
+			return MarshalledTextResult(minimalTeams), nil
+		}
+}
+
+// CollaboratorPermission is the output type of get_collaborator_permission.
+type CollaboratorPermission struct {
+	Username string `json:"username"`
+	// Permission is the highest of admin, maintain, push, triage and pull the user has.
+	Permission string `json:"permission"`
+	// RoleName is the name of the user's repository role, which may be a custom role.
+	RoleName string `json:"role_name,omitempty"`
+}
+
+// GetCollaboratorPermission creates a tool to get the effective permission of a user on a repository.
+func GetCollaboratorPermission(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_collaborator_permission",
+			mcp.WithDescription(t("TOOL_GET_COLLABORATOR_PERMISSION_DESCRIPTION", "Get the effective permission of a user on a repository, whether granted directly, through a team or through organization base permissions. Omit username to get the permission of the authenticated user.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_COLLABORATOR_PERMISSION_USER_TITLE", "Get collaborator permission"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("username",
+				mcp.Description("Username of the user, defaults to the authenticated user"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := OptionalParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if username == "" {
+				user, resp, err := client.Users.Get(ctx, "")
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get authenticated user", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				username = user.GetLogin()
+			}
+
+			level, resp, err := client.Repositories.GetPermissionLevel(ctx, owner, repo, username)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get collaborator permission", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			permission := highestPermission(level.GetUser().GetPermissions())
+			if permission == "" {
+				// The legacy permission is one of admin, write, read or none.
+				permission = level.GetPermission()
+			}
+			return MarshalledTextResult(CollaboratorPermission{
+				Username:   username,
+				Permission: permission,
+				RoleName:   level.GetRoleName(),
+			}), nil
+		}
+}
+
+// AddRepositoryCollaborator creates a tool to invite a user to collaborate on a repository.
+func AddRepositoryCollaborator(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("add_repository_collaborator",
+			mcp.WithDescription(t("TOOL_ADD_REPOSITORY_COLLABORATOR_DESCRIPTION", "Invite a user to collaborate on a repository, or change the permission of an existing collaborator. Requires admin access to the repository. The user has to accept the invitation before getting access.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ADD_REPOSITORY_COLLABORATOR_USER_TITLE", "Add repository collaborator"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Username of the user to add"),
+			),
+			mcp.WithString("permission",
+				mcp.Description("Permission to grant: pull, triage, push, maintain, admin, or the name of a custom repository role (default: push). Only organization repositories support permissions other than push"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := RequiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			permission, err := OptionalParam[string](request, "permission")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			invitation, resp, err := client.Repositories.AddCollaborator(ctx, owner, repo, username, &github.RepositoryAddCollaboratorOptions{
+				Permission: permission,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to add collaborator", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			// Existing collaborators and organization members get access right away, without an invitation.
+			if resp.StatusCode == http.StatusNoContent {
+				return mcp.NewToolResultText(fmt.Sprintf("%s already has access to %s/%s, their permission was updated", username, owner, repo)), nil
+			}
+			return MarshalledTextResult(map[string]any{
+				"invitation_id": invitation.GetID(),
+				"invitee":       invitation.GetInvitee().GetLogin(),
+				"permission":    invitation.GetPermissions(),
+				"html_url":      invitation.GetHTMLURL(),
+			}), nil
+		}
+}
+
+// RemoveRepositoryCollaborator creates a tool to remove a collaborator from a repository.
+func RemoveRepositoryCollaborator(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_repository_collaborator",
+			mcp.WithDescription(t("TOOL_REMOVE_REPOSITORY_COLLABORATOR_DESCRIPTION", "Remove a collaborator from a repository, or cancel their pending invitation. Requires admin access to the repository. Access through teams or organization base permissions is not affected.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_REMOVE_REPOSITORY_COLLABORATOR_USER_TITLE", "Remove repository collaborator"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Username of the collaborator to remove"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := RequiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Repositories.RemoveCollaborator(ctx, owner, repo, username)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to remove collaborator", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("%s was removed from %s/%s", username, owner, repo)), nil
+		}
+}
+
+// SetTeamRepositoryPermission creates a tool to grant, change or remove the access of a team to a repository.
+func SetTeamRepositoryPermission(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("set_team_repository_permission",
+			mcp.WithDescription(t("TOOL_SET_TEAM_REPOSITORY_PERMISSION_DESCRIPTION", "Grant a team of the organization that owns a repository access to it, change the team's permission, or remove the team's access with the none permission. Requires admin access to the repository.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_SET_TEAM_REPOSITORY_PERMISSION_USER_TITLE", "Set team repository permission"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner, the organization of the team"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("team_slug",
+				mcp.Required(),
+				mcp.Description("Slug of the team"),
+			),
+			mcp.WithString("permission",
+				mcp.Required(),
+				mcp.Description("Permission to grant: pull, triage, push, maintain, admin, the name of a custom repository role, or none to remove the team's access"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := RequiredParam[string](request, "team_slug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			permission, err := RequiredParam[string](request, "permission")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Teams can only be granted access to repositories of their own organization.
+			if permission == "none" {
+				resp, err := client.Teams.RemoveTeamRepoBySlug(ctx, owner, teamSlug, owner, repo)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to remove team from repository", resp, err), nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+
+				return mcp.NewToolResultText(fmt.Sprintf("team %s no longer has access to %s/%s", teamSlug, owner, repo)), nil
+			}
+
+			resp, err := client.Teams.AddTeamRepoBySlug(ctx, owner, teamSlug, owner, repo, &github.TeamAddTeamRepoOptions{
+				Permission: permission,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to set team repository permission", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("team %s now has %s permission on %s/%s", teamSlug, permission, owner, repo)), nil
+		}
+}
@@ -0,0 +1,420 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-http/internal/toolsnaps"
+	"github.com/github/github-mcp-http/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListRepositoryCollaborators(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRepositoryCollaborators(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_repository_collaborators", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "affiliation")
+	assert.Contains(t, tool.InputSchema.Properties, "permission")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockCollaborators := []*github.User{
+		{
+			Login:       github.Ptr("octocat"),
+			ID:          github.Ptr(int64(1)),
+			RoleName:    github.Ptr("admin"),
+			Permissions: map[string]bool{"admin": true, "maintain": true, "push": true, "triage": true, "pull": true},
+		},
+		{
+			Login:       github.Ptr("hubot"),
+			ID:          github.Ptr(int64(2)),
+			RoleName:    github.Ptr("write"),
+			Permissions: map[string]bool{"admin": false, "maintain": false, "push": true, "triage": true, "pull": true},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectError    bool
+		expectedResult []MinimalCollaborator
+		expectedErrMsg string
+	}{
+		{
+			name: "collaborators who can push",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCollaboratorsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{"permission": "push", "page": "1", "per_page": "30"}).andThen(
+						mockResponse(t, http.StatusOK, mockCollaborators),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"permission": "push",
+			},
+			expectedResult: []MinimalCollaborator{
+				{Login: "octocat", ID: 1, Permission: "admin", RoleName: "admin"},
+				{Login: "hubot", ID: 2, Permission: "push", RoleName: "write"},
+			},
+		},
+		{
+			name: "no push access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCollaboratorsByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Must have push access to view repository collaborators."}),
+				),
+			),
+			args: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list collaborators",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListRepositoryCollaborators(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned []MinimalCollaborator
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_ListRepositoryTeams(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRepositoryTeams(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_repository_teams", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposTeamsByOwnerByRepo,
+			expectPath(t, "/repos/owner/repo/teams").andThen(
+				mockResponse(t, http.StatusOK, []*github.Team{
+					{
+						Slug:        github.Ptr("maintainers"),
+						Name:        github.Ptr("Maintainers"),
+						Permission:  github.Ptr("push"),
+						Permissions: map[string]bool{"admin": false, "maintain": true, "push": true, "triage": true, "pull": true},
+						Privacy:     github.Ptr("closed"),
+					},
+					{
+						Slug:       github.Ptr("readers"),
+						Name:       github.Ptr("Readers"),
+						Permission: github.Ptr("pull"),
+					},
+				}),
+			),
+		),
+	))
+	_, handler := ListRepositoryTeams(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	var returned []MinimalRepositoryTeam
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+	assert.Equal(t, []MinimalRepositoryTeam{
+		{Slug: "maintainers", Name: "Maintainers", Permission: "maintain", Privacy: "closed"},
+		{Slug: "readers", Name: "Readers", Permission: "pull"},
+	}, returned)
+}
+
+func Test_GetCollaboratorPermission(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCollaboratorPermission(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_collaborator_permission", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "username")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	permissionLevel := func(username string) *github.RepositoryPermissionLevel {
+		return &github.RepositoryPermissionLevel{
+			Permission: github.Ptr("write"),
+			RoleName:   github.Ptr("maintain"),
+			User: &github.User{
+				Login:       github.Ptr(username),
+				Permissions: map[string]bool{"admin": false, "maintain": true, "push": true, "triage": true, "pull": true},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectedResult CollaboratorPermission
+	}{
+		{
+			name: "given user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCollaboratorsPermissionByOwnerByRepoByUsername,
+					expectPath(t, "/repos/owner/repo/collaborators/hubot/permission").andThen(
+						mockResponse(t, http.StatusOK, permissionLevel("hubot")),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"username": "hubot",
+			},
+			expectedResult: CollaboratorPermission{Username: "hubot", Permission: "maintain", RoleName: "maintain"},
+		},
+		{
+			name: "authenticated user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetUser,
+					github.User{Login: github.Ptr("octocat")},
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCollaboratorsPermissionByOwnerByRepoByUsername,
+					expectPath(t, "/repos/owner/repo/collaborators/octocat/permission").andThen(
+						mockResponse(t, http.StatusOK, permissionLevel("octocat")),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedResult: CollaboratorPermission{Username: "octocat", Permission: "maintain", RoleName: "maintain"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetCollaboratorPermission(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			var returned CollaboratorPermission
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_AddRepositoryCollaborator(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AddRepositoryCollaborator(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "add_repository_collaborator", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "username"})
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		expectError      bool
+		expectedContains string
+	}{
+		{
+			name: "invitation sent",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposCollaboratorsByOwnerByRepoByUsername,
+					expectRequestBody(t, map[string]any{"permission": "triage"}).andThen(
+						mockResponse(t, http.StatusCreated, &github.CollaboratorInvitation{
+							ID:          github.Ptr(int64(99)),
+							Invitee:     &github.User{Login: github.Ptr("hubot")},
+							Permissions: github.Ptr("triage"),
+						}),
+					),
+				),
+			),
+			expectedContains: `"invitation_id":99`,
+		},
+		{
+			name: "existing collaborator",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposCollaboratorsByOwnerByRepoByUsername,
+					mockResponse(t, http.StatusNoContent, ""),
+				),
+			),
+			expectedContains: "hubot already has access to owner/repo",
+		},
+		{
+			name: "not an admin",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposCollaboratorsByOwnerByRepoByUsername,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Must have admin rights to Repository."}),
+				),
+			),
+			expectError:      true,
+			expectedContains: "failed to add collaborator",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AddRepositoryCollaborator(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"username":   "hubot",
+				"permission": "triage",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedContains)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			assert.Contains(t, textContent.Text, tc.expectedContains)
+		})
+	}
+}
+
+func Test_RemoveRepositoryCollaborator(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RemoveRepositoryCollaborator(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "remove_repository_collaborator", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "username"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteReposCollaboratorsByOwnerByRepoByUsername,
+			expectPath(t, "/repos/owner/repo/collaborators/hubot").andThen(
+				mockResponse(t, http.StatusNoContent, ""),
+			),
+		),
+	))
+	_, handler := RemoveRepositoryCollaborator(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":    "owner",
+		"repo":     "repo",
+		"username": "hubot",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	assert.Equal(t, "hubot was removed from owner/repo", textContent.Text)
+}
+
+func Test_SetTeamRepositoryPermission(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SetTeamRepositoryPermission(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "set_team_repository_permission", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "team_slug", "permission"})
+
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		permission   string
+		expectedText string
+	}{
+		{
+			name: "grant permission",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutOrgsTeamsReposByOrgByTeamSlugByOwnerByRepo,
+					expect(t, expectations{
+						path:        "/orgs/owner/teams/maintainers/repos/owner/repo",
+						requestBody: map[string]any{"permission": "maintain"},
+					}).andThen(
+						mockResponse(t, http.StatusNoContent, ""),
+					),
+				),
+			),
+			permission:   "maintain",
+			expectedText: "team maintainers now has maintain permission on owner/repo",
+		},
+		{
+			name: "remove access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteOrgsTeamsReposByOrgByTeamSlugByOwnerByRepo,
+					expectPath(t, "/orgs/owner/teams/maintainers/repos/owner/repo").andThen(
+						mockResponse(t, http.StatusNoContent, ""),
+					),
+				),
+			),
+			permission:   "none",
+			expectedText: "team maintainers no longer has access to owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SetTeamRepositoryPermission(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"team_slug":  "maintainers",
+				"permission": tc.permission,
+			}))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
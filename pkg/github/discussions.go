@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
+	ghErrors "github.com/github/github-mcp-http/pkg/errors"
 	"github.com/github/github-mcp-http/pkg/translations"
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/google/go-github/v74/github"
@@ -117,6 +119,69 @@ func getQueryType(useOrdering bool, categoryID *githubv4.ID) any {
 	return &BasicNoOrder{}
 }
 
+// defaultDiscussionRepository is the repository GitHub suggests organisations use for their discussions.
+const defaultDiscussionRepository = ".github"
+
+// DefaultDiscussionRepositoryQuery is the GraphQL query for whether the .github repository of an owner has discussions enabled.
+type DefaultDiscussionRepositoryQuery struct {
+	Repository struct {
+		HasDiscussionsEnabled githubv4.Boolean
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+// DiscussionRepositoriesQuery is the GraphQL query for the repositories of an owner that have discussions enabled.
+type DiscussionRepositoriesQuery struct {
+	RepositoryOwner struct {
+		Repositories struct {
+			Nodes []struct {
+				Name                  githubv4.String
+				HasDiscussionsEnabled githubv4.Boolean
+			}
+		} `graphql:"repositories(first: 100, orderBy: {field: PUSHED_AT, direction: DESC})"`
+	} `graphql:"repositoryOwner(login: $owner)"`
+}
+
+// getOrganizationDiscussionRepository finds the repository holding the organisation-level discussions of an owner.
+// The API does not expose which repository was chosen, so the .github repository is preferred, followed by the
+// only repository with discussions enabled among the owner's 100 most recently pushed repositories.
+func getOrganizationDiscussionRepository(ctx context.Context, client *githubv4.Client, owner string) (string, error) {
+	// The .github repository is looked up on its own, as GitHub fails the whole query with
+	// NOT_FOUND when it does not exist.
+	var defaultQuery DefaultDiscussionRepositoryQuery
+	err := client.Query(ctx, &defaultQuery, map[string]any{
+		"owner": githubv4.String(owner),
+		"name":  githubv4.String(defaultDiscussionRepository),
+	})
+	switch {
+	case err == nil && bool(defaultQuery.Repository.HasDiscussionsEnabled):
+		return defaultDiscussionRepository, nil
+	case err != nil && !strings.Contains(err.Error(), "Could not resolve to a Repository"):
+		return "", fmt.Errorf("failed to find the discussion repository of %s: %w", owner, err)
+	}
+
+	var query DiscussionRepositoriesQuery
+	if err := client.Query(ctx, &query, map[string]any{
+		"owner": githubv4.String(owner),
+	}); err != nil {
+		return "", fmt.Errorf("failed to find the discussion repository of %s: %w", owner, err)
+	}
+
+	var candidates []string
+	for _, repo := range query.RepositoryOwner.Repositories.Nodes {
+		if repo.HasDiscussionsEnabled {
+			candidates = append(candidates, string(repo.Name))
+		}
+	}
+	switch len(candidates) {
+	case 0:
+		return "", fmt.Errorf("%s has no repository with discussions enabled", owner)
+	case 1:
+		return candidates[0], nil
+	default:
+		return "", fmt.Errorf("several repositories of %s have discussions enabled, provide repo as one of: %s", owner, strings.Join(candidates, ", "))
+	}
+}
+
 func ListDiscussions(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_discussions",
 			mcp.WithDescription(t("TOOL_LIST_DISCUSSIONS_DESCRIPTION", "List discussions for a repository or organisation.")),
@@ -129,7 +194,7 @@ func ListDiscussions(getGQLClient GetGQLClientFn, t translations.TranslationHelp
 				mcp.Description("Repository owner"),
 			),
 			mcp.WithString("repo",
-				mcp.Description("Repository name. If not provided, discussions will be queried at the organisation level."),
+				mcp.Description("Repository name. If not provided, discussions will be queried at the organisation level, from the repository that holds the organisation's discussions."),
 			),
 			mcp.WithString("category",
 				mcp.Description("Optional filter by discussion category ID. If provided, only discussions with this category are listed."),
@@ -153,11 +218,6 @@ func ListDiscussions(getGQLClient GetGQLClientFn, t translations.TranslationHelp
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			// when not provided, default to the .github repository
-			// this will query discussions at the organisation level
-			if repo == "" {
-				repo = ".github"
-			}
 
 			category, err := OptionalParam[string](request, "category")
 			if err != nil {
@@ -189,6 +249,13 @@ func ListDiscussions(getGQLClient GetGQLClientFn, t translations.TranslationHelp
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
 			}
 
+			// when not provided, query discussions at the organisation level
+			if repo == "" {
+				if repo, err = getOrganizationDiscussionRepository(ctx, client, owner); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+
 			var categoryID *githubv4.ID
 			if category != "" {
 				id := githubv4.ID(category)
@@ -387,6 +454,7 @@ func GetDiscussionComments(getGQLClient GetGQLClientFn, t translations.Translati
 					Discussion struct {
 						Comments struct {
 							Nodes []struct {
+								ID   githubv4.ID
 								Body githubv4.String
 							}
 							PageInfo struct {
@@ -417,7 +485,10 @@ func GetDiscussionComments(getGQLClient GetGQLClientFn, t translations.Translati
 
 			var comments []*github.IssueComment
 			for _, c := range q.Repository.Discussion.Comments.Nodes {
-				comments = append(comments, &github.IssueComment{Body: github.Ptr(string(c.Body))})
+				comments = append(comments, &github.IssueComment{
+					NodeID: github.Ptr(fmt.Sprint(c.ID)),
+					Body:   github.Ptr(string(c.Body)),
+				})
 			}
 
 			// Create response with pagination info
@@ -453,7 +524,7 @@ func ListDiscussionCategories(getGQLClient GetGQLClientFn, t translations.Transl
 				mcp.Description("Repository owner"),
 			),
 			mcp.WithString("repo",
-				mcp.Description("Repository name. If not provided, discussion categories will be queried at the organisation level."),
+				mcp.Description("Repository name. If not provided, discussion categories will be queried at the organisation level, from the repository that holds the organisation's discussions."),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -465,17 +536,19 @@ func ListDiscussionCategories(getGQLClient GetGQLClientFn, t translations.Transl
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			// when not provided, default to the .github repository
-			// this will query discussion categories at the organisation level
-			if repo == "" {
-				repo = ".github"
-			}
 
 			client, err := getGQLClient(ctx)
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
 			}
 
+			// when not provided, query discussion categories at the organisation level
+			if repo == "" {
+				if repo, err = getOrganizationDiscussionRepository(ctx, client, owner); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+
 			var q struct {
 				Repository struct {
 					DiscussionCategories struct {
@@ -529,3 +602,713 @@ func ListDiscussionCategories(getGQLClient GetGQLClientFn, t translations.Transl
 			return mcp.NewToolResultText(string(out)), nil
 		}
 }
+
+// DiscussionIDQuery is the GraphQL query for the node ID of a discussion, which the discussion mutations take.
+type DiscussionIDQuery struct {
+	Repository struct {
+		Discussion struct {
+			ID githubv4.ID
+		} `graphql:"discussion(number: $discussionNumber)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// getDiscussionID resolves the node ID of a discussion from its number.
+func getDiscussionID(ctx context.Context, client *githubv4.Client, owner, repo string, discussionNumber int) (githubv4.ID, error) {
+	var query DiscussionIDQuery
+	if err := client.Query(ctx, &query, map[string]any{
+		"owner":            githubv4.String(owner),
+		"repo":             githubv4.String(repo),
+		"discussionNumber": githubv4.Int(discussionNumber), // #nosec G115 - discussion numbers are always small positive integers
+	}); err != nil {
+		return nil, err
+	}
+	return query.Repository.Discussion.ID, nil
+}
+
+// discussionRefParams reads the owner, repo and discussionNumber parameters shared by the discussion write tools.
+func discussionRefParams(request mcp.CallToolRequest) (string, string, int, error) {
+	owner, err := RequiredParam[string](request, "owner")
+	if err != nil {
+		return "", "", 0, err
+	}
+	repo, err := RequiredParam[string](request, "repo")
+	if err != nil {
+		return "", "", 0, err
+	}
+	discussionNumber, err := RequiredInt(request, "discussionNumber")
+	if err != nil {
+		return "", "", 0, err
+	}
+	return owner, repo, discussionNumber, nil
+}
+
+// withDiscussionRef adds the owner, repo and discussionNumber parameters to a tool.
+func withDiscussionRef() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner"),
+		)(tool)
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		)(tool)
+		mcp.WithNumber("discussionNumber",
+			mcp.Required(),
+			mcp.Description("Discussion Number"),
+		)(tool)
+	}
+}
+
+// DiscussionStateFragment is the state of a discussion returned by the discussion mutations.
+type DiscussionStateFragment struct {
+	ID         githubv4.ID
+	Number     githubv4.Int
+	URL        githubv4.String `graphql:"url"`
+	Closed     githubv4.Boolean
+	Locked     githubv4.Boolean
+	IsAnswered githubv4.Boolean
+}
+
+func convertToMinimalDiscussionResponse(fragment DiscussionStateFragment) MinimalDiscussionResponse {
+	return MinimalDiscussionResponse{
+		ID:       fmt.Sprint(fragment.ID),
+		Number:   int(fragment.Number),
+		URL:      string(fragment.URL),
+		Closed:   bool(fragment.Closed),
+		Locked:   bool(fragment.Locked),
+		Answered: bool(fragment.IsAnswered),
+	}
+}
+
+// CreateDiscussion creates a tool to start a discussion in a repository.
+func CreateDiscussion(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_discussion",
+			mcp.WithDescription(t("TOOL_CREATE_DISCUSSION_DESCRIPTION", "Create a discussion in a repository. Use list_discussion_categories to find the ID of the category to post in.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_DISCUSSION_USER_TITLE", "Create discussion"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("category",
+				mcp.Required(),
+				mcp.Description("ID of the discussion category"),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Discussion title"),
+			),
+			mcp.WithString("body",
+				mcp.Required(),
+				mcp.Description("Discussion body in markdown"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			category, err := RequiredParam[string](request, "category")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			title, err := RequiredParam[string](request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := RequiredParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var repoQuery struct {
+				Repository struct {
+					ID githubv4.ID
+				} `graphql:"repository(owner: $owner, name: $repo)"`
+			}
+			if err := client.Query(ctx, &repoQuery, map[string]any{
+				"owner": githubv4.String(owner),
+				"repo":  githubv4.String(repo),
+			}); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to find repository", err), nil
+			}
+
+			var mutation struct {
+				CreateDiscussion struct {
+					Discussion DiscussionStateFragment
+				} `graphql:"createDiscussion(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, githubv4.CreateDiscussionInput{
+				RepositoryID: repoQuery.Repository.ID,
+				CategoryID:   githubv4.ID(category),
+				Title:        githubv4.String(title),
+				Body:         githubv4.String(body),
+			}, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to create discussion", err), nil
+			}
+
+			return MarshalledTextResult(convertToMinimalDiscussionResponse(mutation.CreateDiscussion.Discussion)), nil
+		}
+}
+
+// AddDiscussionComment creates a tool to comment on a discussion, or reply to one of its comments.
+func AddDiscussionComment(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_discussion_comment",
+			mcp.WithDescription(t("TOOL_ADD_DISCUSSION_COMMENT_DESCRIPTION", "Add a comment to a discussion. Set replyToId to reply in the thread of an existing top-level comment instead.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ADD_DISCUSSION_COMMENT_USER_TITLE", "Add discussion comment"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			withDiscussionRef(),
+			mcp.WithString("body",
+				mcp.Required(),
+				mcp.Description("Comment body in markdown"),
+			),
+			mcp.WithString("replyToId",
+				mcp.Description("Node ID of the top-level comment to reply to, as returned by get_discussion_comments"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, discussionNumber, err := discussionRefParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := RequiredParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			replyToID, err := OptionalParam[string](request, "replyToId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			discussionID, err := getDiscussionID(ctx, client, owner, repo, discussionNumber)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to find discussion", err), nil
+			}
+
+			input := githubv4.AddDiscussionCommentInput{
+				DiscussionID: discussionID,
+				Body:         githubv4.String(body),
+			}
+			if replyToID != "" {
+				input.ReplyToID = githubv4.NewID(githubv4.ID(replyToID))
+			}
+
+			var mutation struct {
+				AddDiscussionComment struct {
+					Comment struct {
+						ID  githubv4.ID
+						URL githubv4.String `graphql:"url"`
+					}
+				} `graphql:"addDiscussionComment(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to add discussion comment", err), nil
+			}
+
+			return MarshalledTextResult(MinimalResponse{
+				ID:  fmt.Sprint(mutation.AddDiscussionComment.Comment.ID),
+				URL: string(mutation.AddDiscussionComment.Comment.URL),
+			}), nil
+		}
+}
+
+// MarkDiscussionCommentAsAnswer creates a tool to mark, or unmark, a discussion comment as the answer.
+func MarkDiscussionCommentAsAnswer(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("mark_discussion_comment_as_answer",
+			mcp.WithDescription(t("TOOL_MARK_DISCUSSION_COMMENT_AS_ANSWER_DESCRIPTION", "Mark a comment as the answer to a discussion, or unmark it. Only discussions in categories that accept answers, such as Q&A, can have an answer.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:          t("TOOL_MARK_DISCUSSION_COMMENT_AS_ANSWER_USER_TITLE", "Mark discussion comment as answer"),
+				ReadOnlyHint:   ToBoolPtr(false),
+				IdempotentHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("commentId",
+				mcp.Required(),
+				mcp.Description("Node ID of the comment, as returned by get_discussion_comments"),
+			),
+			mcp.WithBoolean("unmark",
+				mcp.Description("Unmark the comment as the answer instead"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			commentID, err := RequiredParam[string](request, "commentId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			unmark, err := OptionalParam[bool](request, "unmark")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			if unmark {
+				var mutation struct {
+					UnmarkDiscussionCommentAsAnswer struct {
+						Discussion DiscussionStateFragment
+					} `graphql:"unmarkDiscussionCommentAsAnswer(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &mutation, githubv4.UnmarkDiscussionCommentAsAnswerInput{
+					ID: githubv4.ID(commentID),
+				}, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to unmark discussion comment as answer", err), nil
+				}
+				return MarshalledTextResult(convertToMinimalDiscussionResponse(mutation.UnmarkDiscussionCommentAsAnswer.Discussion)), nil
+			}
+
+			var mutation struct {
+				MarkDiscussionCommentAsAnswer struct {
+					Discussion DiscussionStateFragment
+				} `graphql:"markDiscussionCommentAsAnswer(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, githubv4.MarkDiscussionCommentAsAnswerInput{
+				ID: githubv4.ID(commentID),
+			}, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to mark discussion comment as answer", err), nil
+			}
+			return MarshalledTextResult(convertToMinimalDiscussionResponse(mutation.MarkDiscussionCommentAsAnswer.Discussion)), nil
+		}
+}
+
+// UpdateDiscussion creates a tool to edit the title, body or category of a discussion.
+func UpdateDiscussion(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_discussion",
+			mcp.WithDescription(t("TOOL_UPDATE_DISCUSSION_DESCRIPTION", "Update the title, body or category of a discussion. Use close_discussion, reopen_discussion and lock_discussion to change its state.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_DISCUSSION_USER_TITLE", "Update discussion"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			withDiscussionRef(),
+			mcp.WithString("title",
+				mcp.Description("New title"),
+			),
+			mcp.WithString("body",
+				mcp.Description("New body in markdown"),
+			),
+			mcp.WithString("category",
+				mcp.Description("ID of the category to move the discussion to"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, discussionNumber, err := discussionRefParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			title, err := OptionalParam[string](request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, bodyProvided, err := OptionalParamOK[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			category, err := OptionalParam[string](request, "category")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if title == "" && !bodyProvided && category == "" {
+				return mcp.NewToolResultError("No update parameters provided."), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			discussionID, err := getDiscussionID(ctx, client, owner, repo, discussionNumber)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to find discussion", err), nil
+			}
+
+			input := githubv4.UpdateDiscussionInput{
+				DiscussionID: discussionID,
+				Title:        newGQLStringlike[githubv4.String](title),
+			}
+			if bodyProvided {
+				input.Body = githubv4.NewString(githubv4.String(body))
+			}
+			if category != "" {
+				input.CategoryID = githubv4.NewID(githubv4.ID(category))
+			}
+
+			var mutation struct {
+				UpdateDiscussion struct {
+					Discussion DiscussionStateFragment
+				} `graphql:"updateDiscussion(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to update discussion", err), nil
+			}
+
+			return MarshalledTextResult(convertToMinimalDiscussionResponse(mutation.UpdateDiscussion.Discussion)), nil
+		}
+}
+
+// CloseDiscussion creates a tool to close a discussion.
+func CloseDiscussion(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("close_discussion",
+			mcp.WithDescription(t("TOOL_CLOSE_DISCUSSION_DESCRIPTION", "Close a discussion, optionally recording why it was closed.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CLOSE_DISCUSSION_USER_TITLE", "Close discussion"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			withDiscussionRef(),
+			mcp.WithString("reason",
+				mcp.Description("Why the discussion is closed (default: RESOLVED)"),
+				mcp.Enum("RESOLVED", "OUTDATED", "DUPLICATE"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, discussionNumber, err := discussionRefParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			reason, err := OptionalParam[string](request, "reason")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			discussionID, err := getDiscussionID(ctx, client, owner, repo, discussionNumber)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to find discussion", err), nil
+			}
+
+			input := githubv4.CloseDiscussionInput{DiscussionID: discussionID}
+			if reason != "" {
+				closeReason := githubv4.DiscussionCloseReason(reason)
+				input.Reason = &closeReason
+			}
+
+			var mutation struct {
+				CloseDiscussion struct {
+					Discussion DiscussionStateFragment
+				} `graphql:"closeDiscussion(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to close discussion", err), nil
+			}
+
+			return MarshalledTextResult(convertToMinimalDiscussionResponse(mutation.CloseDiscussion.Discussion)), nil
+		}
+}
+
+// ReopenDiscussion creates a tool to reopen a closed discussion.
+func ReopenDiscussion(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("reopen_discussion",
+			mcp.WithDescription(t("TOOL_REOPEN_DISCUSSION_DESCRIPTION", "Reopen a closed discussion.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_REOPEN_DISCUSSION_USER_TITLE", "Reopen discussion"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			withDiscussionRef(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, discussionNumber, err := discussionRefParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			discussionID, err := getDiscussionID(ctx, client, owner, repo, discussionNumber)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to find discussion", err), nil
+			}
+
+			var mutation struct {
+				ReopenDiscussion struct {
+					Discussion DiscussionStateFragment
+				} `graphql:"reopenDiscussion(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, githubv4.ReopenDiscussionInput{DiscussionID: discussionID}, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to reopen discussion", err), nil
+			}
+
+			return MarshalledTextResult(convertToMinimalDiscussionResponse(mutation.ReopenDiscussion.Discussion)), nil
+		}
+}
+
+// LockDiscussion creates a tool to lock or unlock the conversation of a discussion.
+func LockDiscussion(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("lock_discussion",
+			mcp.WithDescription(t("TOOL_LOCK_DISCUSSION_DESCRIPTION", "Lock a discussion so only collaborators can comment on it, or unlock it again.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:          t("TOOL_LOCK_DISCUSSION_USER_TITLE", "Lock or unlock discussion"),
+				ReadOnlyHint:   ToBoolPtr(false),
+				IdempotentHint: ToBoolPtr(true),
+			}),
+			withDiscussionRef(),
+			mcp.WithBoolean("unlock",
+				mcp.Description("Unlock the discussion instead"),
+			),
+			mcp.WithString("reason",
+				mcp.Description("Why the discussion is locked. Ignored when unlocking"),
+				mcp.Enum("OFF_TOPIC", "TOO_HEATED", "RESOLVED", "SPAM"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, discussionNumber, err := discussionRefParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			unlock, err := OptionalParam[bool](request, "unlock")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			reason, err := OptionalParam[string](request, "reason")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			discussionID, err := getDiscussionID(ctx, client, owner, repo, discussionNumber)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to find discussion", err), nil
+			}
+
+			// The lock mutations return the locked record as a Lockable, so the state of the discussion is read through it.
+			if unlock {
+				var mutation struct {
+					UnlockLockable struct {
+						UnlockedRecord struct {
+							Discussion DiscussionStateFragment `graphql:"... on Discussion"`
+						}
+					} `graphql:"unlockLockable(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &mutation, githubv4.UnlockLockableInput{LockableID: discussionID}, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to unlock discussion", err), nil
+				}
+				return MarshalledTextResult(convertToMinimalDiscussionResponse(mutation.UnlockLockable.UnlockedRecord.Discussion)), nil
+			}
+
+			input := githubv4.LockLockableInput{LockableID: discussionID}
+			if reason != "" {
+				lockReason := githubv4.LockReason(reason)
+				input.LockReason = &lockReason
+			}
+
+			var mutation struct {
+				LockLockable struct {
+					LockedRecord struct {
+						Discussion DiscussionStateFragment `graphql:"... on Discussion"`
+					}
+				} `graphql:"lockLockable(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to lock discussion", err), nil
+			}
+			return MarshalledTextResult(convertToMinimalDiscussionResponse(mutation.LockLockable.LockedRecord.Discussion)), nil
+		}
+}
+
+// SearchDiscussionsQuery is the GraphQL query for discussions matching a search query.
+type SearchDiscussionsQuery struct {
+	Search struct {
+		DiscussionCount githubv4.Int
+		PageInfo        PageInfoFragment
+		Nodes           []struct {
+			Discussion struct {
+				Number     githubv4.Int
+				Title      githubv4.String
+				URL        githubv4.String `graphql:"url"`
+				Closed     githubv4.Boolean
+				IsAnswered githubv4.Boolean
+				CreatedAt  githubv4.DateTime
+				UpdatedAt  githubv4.DateTime
+				Author     struct {
+					Login githubv4.String
+				}
+				Category struct {
+					Name githubv4.String
+				}
+				Repository struct {
+					NameWithOwner githubv4.String
+				}
+				Comments struct {
+					TotalCount githubv4.Int
+				}
+			} `graphql:"... on Discussion"`
+		}
+	} `graphql:"search(query: $query, type: DISCUSSION, first: $first, after: $after)"`
+}
+
+// SearchDiscussions creates a tool to search discussions across repositories.
+func SearchDiscussions(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("search_discussions",
+			mcp.WithDescription(t("TOOL_SEARCH_DISCUSSIONS_DESCRIPTION", "Search discussions across GitHub, an owner's repositories or a single repository. Give only owner to search every discussion of an organisation.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SEARCH_DISCUSSIONS_USER_TITLE", "Search discussions"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("query",
+				mcp.Description("Search query using GitHub discussion search syntax, e.g. 'memory leak in:title author:octocat'"),
+			),
+			mcp.WithString("owner",
+				mcp.Description("Only search discussions in repositories of this owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Only search discussions in this repository. Requires owner"),
+			),
+			mcp.WithBoolean("answered",
+				mcp.Description("Only return answered discussions when true, or unanswered ones when false"),
+			),
+			mcp.WithString("category",
+				mcp.Description("Only return discussions in the category with this name"),
+			),
+			mcp.WithString("state",
+				mcp.Description("Only return open or closed discussions"),
+				mcp.Enum("open", "closed"),
+			),
+			WithCursorPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			query, err := OptionalParam[string](request, "query")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := OptionalParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			answered, answeredProvided, err := OptionalParamOK[bool](request, "answered")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			category, err := OptionalParam[string](request, "category")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := OptionalParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalCursorPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			paginationParams, err := pagination.ToGraphQLParams()
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if repo != "" && owner == "" {
+				return mcp.NewToolResultError("owner is required when repo is provided"), nil
+			}
+
+			terms := []string{}
+			switch {
+			case repo != "" && !hasRepoFilter(query):
+				terms = append(terms, fmt.Sprintf("repo:%s/%s", owner, repo))
+			case repo == "" && owner != "" && !hasFilter(query, "user") && !hasFilter(query, "org"):
+				terms = append(terms, fmt.Sprintf("user:%s", owner))
+			}
+			if answeredProvided {
+				if answered {
+					terms = append(terms, "is:answered")
+				} else {
+					terms = append(terms, "is:unanswered")
+				}
+			}
+			if category != "" {
+				terms = append(terms, fmt.Sprintf("category:%q", category))
+			}
+			if state != "" {
+				terms = append(terms, "is:"+state)
+			}
+			if query != "" {
+				terms = append(terms, query)
+			}
+			if len(terms) == 0 {
+				return mcp.NewToolResultError("provide a query, an owner or at least one filter"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			vars := map[string]any{
+				"query": githubv4.String(strings.Join(terms, " ")),
+				"first": githubv4.Int(*paginationParams.First),
+			}
+			if paginationParams.After != nil {
+				vars["after"] = githubv4.String(*paginationParams.After)
+			} else {
+				vars["after"] = (*githubv4.String)(nil)
+			}
+
+			var q SearchDiscussionsQuery
+			if err := client.Query(ctx, &q, vars); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to search discussions", err), nil
+			}
+
+			discussions := make([]MinimalDiscussion, 0, len(q.Search.Nodes))
+			for _, node := range q.Search.Nodes {
+				d := node.Discussion
+				discussions = append(discussions, MinimalDiscussion{
+					Number:     int(d.Number),
+					Title:      string(d.Title),
+					Repository: string(d.Repository.NameWithOwner),
+					Category:   string(d.Category.Name),
+					Author:     string(d.Author.Login),
+					Closed:     bool(d.Closed),
+					Answered:   bool(d.IsAnswered),
+					Comments:   int(d.Comments.TotalCount),
+					URL:        string(d.URL),
+					CreatedAt:  d.CreatedAt.Format("2006-01-02T15:04:05Z"),
+					UpdatedAt:  d.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+				})
+			}
+
+			return MarshalledTextResult(map[string]any{
+				"discussions": discussions,
+				"pageInfo": map[string]any{
+					"hasNextPage":     q.Search.PageInfo.HasNextPage,
+					"hasPreviousPage": q.Search.PageInfo.HasPreviousPage,
+					"startCursor":     string(q.Search.PageInfo.StartCursor),
+					"endCursor":       string(q.Search.PageInfo.EndCursor),
+				},
+				"totalCount": int(q.Search.DiscussionCount),
+			}), nil
+		}
+}
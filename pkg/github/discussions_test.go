@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/github/github-mcp-http/internal/githubv4mock"
+	"github.com/github/github-mcp-http/internal/toolsnaps"
 	"github.com/github/github-mcp-http/pkg/translations"
 	"github.com/google/go-github/v74/github"
 	"github.com/shurcooL/githubv4"
@@ -261,7 +262,7 @@ func Test_ListDiscussions(t *testing.T) {
 
 	varsOrgLevel := map[string]interface{}{
 		"owner": "owner",
-		"repo":  ".github", // This is what gets set when repo is not provided
+		"repo":  ".github", // This is what gets resolved when repo is not provided
 		"first": float64(30),
 		"after": (*string)(nil),
 	}
@@ -380,7 +381,7 @@ func Test_ListDiscussions(t *testing.T) {
 			name: "list org-level discussions (no repo provided)",
 			reqParams: map[string]interface{}{
 				"owner": "owner",
-				// repo is not provided, it will default to ".github"
+				// repo is not provided, it will be resolved to ".github", which has discussions enabled
 			},
 			expectError:   false,
 			expectedCount: 4,
@@ -424,7 +425,7 @@ func Test_ListDiscussions(t *testing.T) {
 				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
 			case "list org-level discussions (no repo provided)":
 				matcher := githubv4mock.NewQueryMatcher(qBasicNoOrder, varsOrgLevel, mockResponseOrgLevel)
-				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
+				httpClient = githubv4mock.NewMockedHTTPClient(defaultDiscussionRepositoryMatcher(true), matcher)
 			}
 
 			gqlClient := githubv4.NewClient(httpClient)
@@ -570,7 +571,7 @@ func Test_GetDiscussionComments(t *testing.T) {
 	assert.ElementsMatch(t, toolDef.InputSchema.Required, []string{"owner", "repo", "discussionNumber"})
 
 	// Use exact string query that matches implementation output
-	qGetComments := "query($after:String$discussionNumber:Int!$first:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussion(number: $discussionNumber){comments(first: $first, after: $after){nodes{body},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}}"
+	qGetComments := "query($after:String$discussionNumber:Int!$first:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussion(number: $discussionNumber){comments(first: $first, after: $after){nodes{id,body},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}}"
 
 	// Variables matching what GraphQL receives after JSON marshaling/unmarshaling
 	vars := map[string]interface{}{
@@ -586,8 +587,8 @@ func Test_GetDiscussionComments(t *testing.T) {
 			"discussion": map[string]any{
 				"comments": map[string]any{
 					"nodes": []map[string]any{
-						{"body": "This is the first comment"},
-						{"body": "This is the second comment"},
+						{"id": "DC_kwDOA1", "body": "This is the first comment"},
+						{"id": "DC_kwDOA2", "body": "This is the second comment"},
 					},
 					"pageInfo": map[string]any{
 						"hasNextPage":     false,
@@ -632,8 +633,10 @@ func Test_GetDiscussionComments(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, response.Comments, 2)
 	expectedBodies := []string{"This is the first comment", "This is the second comment"}
+	expectedIDs := []string{"DC_kwDOA1", "DC_kwDOA2"}
 	for i, comment := range response.Comments {
 		assert.Equal(t, expectedBodies[i], *comment.Body)
+		assert.Equal(t, expectedIDs[i], comment.GetNodeID())
 	}
 }
 
@@ -729,7 +732,7 @@ func Test_ListDiscussionCategories(t *testing.T) {
 			name: "list org-level discussion categories (no repo provided)",
 			reqParams: map[string]interface{}{
 				"owner": "owner",
-				// repo is not provided, it will default to ".github"
+				// repo is not provided, it will be resolved to ".github", which has discussions enabled
 			},
 			vars:          varsOrg,
 			mockResponse:  mockRespOrg,
@@ -745,8 +748,11 @@ func Test_ListDiscussionCategories(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			matcher := githubv4mock.NewQueryMatcher(qListCategories, tc.vars, tc.mockResponse)
-			httpClient := githubv4mock.NewMockedHTTPClient(matcher)
+			matchers := []githubv4mock.Matcher{githubv4mock.NewQueryMatcher(qListCategories, tc.vars, tc.mockResponse)}
+			if _, ok := tc.reqParams["repo"]; !ok {
+				matchers = append(matchers, defaultDiscussionRepositoryMatcher(true))
+			}
+			httpClient := githubv4mock.NewMockedHTTPClient(matchers...)
 			gqlClient := githubv4.NewClient(httpClient)
 
 			_, handler := ListDiscussionCategories(stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
@@ -776,3 +782,728 @@ func Test_ListDiscussionCategories(t *testing.T) {
 		})
 	}
 }
+
+// discussionIDMatcher matches the query resolving the node ID of discussion owner/repo#7.
+func discussionIDMatcher() githubv4mock.Matcher {
+	return githubv4mock.NewQueryMatcher(
+		DiscussionIDQuery{},
+		map[string]any{
+			"owner":            githubv4.String("owner"),
+			"repo":             githubv4.String("repo"),
+			"discussionNumber": githubv4.Int(7),
+		},
+		githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{
+				"discussion": map[string]any{"id": "D_kwDOA7"},
+			},
+		}),
+	)
+}
+
+func discussionState(closed, locked, answered bool) map[string]any {
+	return map[string]any{
+		"id":         "D_kwDOA7",
+		"number":     7,
+		"url":        "https://github.com/owner/repo/discussions/7",
+		"closed":     closed,
+		"locked":     locked,
+		"isAnswered": answered,
+	}
+}
+
+func Test_CreateDiscussion(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := CreateDiscussion(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_discussion", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "category", "title", "body"})
+
+	httpClient := githubv4mock.NewMockedHTTPClient(
+		githubv4mock.NewQueryMatcher(
+			struct {
+				Repository struct {
+					ID githubv4.ID
+				} `graphql:"repository(owner: $owner, name: $repo)"`
+			}{},
+			map[string]any{
+				"owner": githubv4.String("owner"),
+				"repo":  githubv4.String("repo"),
+			},
+			githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{"id": "R_kgDOA1"},
+			}),
+		),
+		githubv4mock.NewMutationMatcher(
+			struct {
+				CreateDiscussion struct {
+					Discussion DiscussionStateFragment
+				} `graphql:"createDiscussion(input: $input)"`
+			}{},
+			githubv4.CreateDiscussionInput{
+				RepositoryID: githubv4.ID("R_kgDOA1"),
+				CategoryID:   githubv4.ID("DIC_kwDOA1"),
+				Title:        githubv4.String("How do I configure X?"),
+				Body:         githubv4.String("Details"),
+			},
+			nil,
+			githubv4mock.DataResponse(map[string]any{
+				"createDiscussion": map[string]any{"discussion": discussionState(false, false, false)},
+			}),
+		),
+	)
+
+	_, handler := CreateDiscussion(stubGetGQLClientFn(githubv4.NewClient(httpClient)), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":    "owner",
+		"repo":     "repo",
+		"category": "DIC_kwDOA1",
+		"title":    "How do I configure X?",
+		"body":     "Details",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned MinimalDiscussionResponse
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, MinimalDiscussionResponse{
+		ID:     "D_kwDOA7",
+		Number: 7,
+		URL:    "https://github.com/owner/repo/discussions/7",
+	}, returned)
+}
+
+func Test_AddDiscussionComment(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := AddDiscussionComment(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "add_discussion_comment", tool.Name)
+	assert.Contains(t, tool.InputSchema.Properties, "replyToId")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "discussionNumber", "body"})
+
+	mutation := struct {
+		AddDiscussionComment struct {
+			Comment struct {
+				ID  githubv4.ID
+				URL githubv4.String `graphql:"url"`
+			}
+		} `graphql:"addDiscussionComment(input: $input)"`
+	}{}
+	commentResponse := githubv4mock.DataResponse(map[string]any{
+		"addDiscussionComment": map[string]any{
+			"comment": map[string]any{
+				"id":  "DC_kwDOA3",
+				"url": "https://github.com/owner/repo/discussions/7#discussioncomment-3",
+			},
+		},
+	})
+
+	tests := []struct {
+		name        string
+		requestArgs map[string]any
+		input       githubv4.AddDiscussionCommentInput
+	}{
+		{
+			name: "top-level comment",
+			requestArgs: map[string]any{
+				"owner":            "owner",
+				"repo":             "repo",
+				"discussionNumber": float64(7),
+				"body":             "Thanks!",
+			},
+			input: githubv4.AddDiscussionCommentInput{
+				DiscussionID: githubv4.ID("D_kwDOA7"),
+				Body:         githubv4.String("Thanks!"),
+			},
+		},
+		{
+			name: "threaded reply",
+			requestArgs: map[string]any{
+				"owner":            "owner",
+				"repo":             "repo",
+				"discussionNumber": float64(7),
+				"body":             "Agreed",
+				"replyToId":        "DC_kwDOA1",
+			},
+			input: githubv4.AddDiscussionCommentInput{
+				DiscussionID: githubv4.ID("D_kwDOA7"),
+				Body:         githubv4.String("Agreed"),
+				ReplyToID:    githubv4.NewID(githubv4.ID("DC_kwDOA1")),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			httpClient := githubv4mock.NewMockedHTTPClient(
+				discussionIDMatcher(),
+				githubv4mock.NewMutationMatcher(mutation, tc.input, nil, commentResponse),
+			)
+			_, handler := AddDiscussionComment(stubGetGQLClientFn(githubv4.NewClient(httpClient)), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var returned MinimalResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, "DC_kwDOA3", returned.ID)
+		})
+	}
+}
+
+func Test_MarkDiscussionCommentAsAnswer(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := MarkDiscussionCommentAsAnswer(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "mark_discussion_comment_as_answer", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"commentId"})
+
+	tests := []struct {
+		name             string
+		matcher          githubv4mock.Matcher
+		requestArgs      map[string]any
+		expectedAnswered bool
+	}{
+		{
+			name: "mark as answer",
+			matcher: githubv4mock.NewMutationMatcher(
+				struct {
+					MarkDiscussionCommentAsAnswer struct {
+						Discussion DiscussionStateFragment
+					} `graphql:"markDiscussionCommentAsAnswer(input: $input)"`
+				}{},
+				githubv4.MarkDiscussionCommentAsAnswerInput{ID: githubv4.ID("DC_kwDOA1")},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"markDiscussionCommentAsAnswer": map[string]any{"discussion": discussionState(false, false, true)},
+				}),
+			),
+			requestArgs:      map[string]any{"commentId": "DC_kwDOA1"},
+			expectedAnswered: true,
+		},
+		{
+			name: "unmark as answer",
+			matcher: githubv4mock.NewMutationMatcher(
+				struct {
+					UnmarkDiscussionCommentAsAnswer struct {
+						Discussion DiscussionStateFragment
+					} `graphql:"unmarkDiscussionCommentAsAnswer(input: $input)"`
+				}{},
+				githubv4.UnmarkDiscussionCommentAsAnswerInput{ID: githubv4.ID("DC_kwDOA1")},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"unmarkDiscussionCommentAsAnswer": map[string]any{"discussion": discussionState(false, false, false)},
+				}),
+			),
+			requestArgs:      map[string]any{"commentId": "DC_kwDOA1", "unmark": true},
+			expectedAnswered: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			httpClient := githubv4mock.NewMockedHTTPClient(tc.matcher)
+			_, handler := MarkDiscussionCommentAsAnswer(stubGetGQLClientFn(githubv4.NewClient(httpClient)), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var returned MinimalDiscussionResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expectedAnswered, returned.Answered)
+		})
+	}
+}
+
+func Test_UpdateDiscussion(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := UpdateDiscussion(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_discussion", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "discussionNumber"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "move to another category and retitle",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				discussionIDMatcher(),
+				githubv4mock.NewMutationMatcher(
+					struct {
+						UpdateDiscussion struct {
+							Discussion DiscussionStateFragment
+						} `graphql:"updateDiscussion(input: $input)"`
+					}{},
+					githubv4.UpdateDiscussionInput{
+						DiscussionID: githubv4.ID("D_kwDOA7"),
+						Title:        githubv4.NewString("New title"),
+						CategoryID:   githubv4.NewID(githubv4.ID("DIC_kwDOA2")),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"updateDiscussion": map[string]any{"discussion": discussionState(false, false, false)},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":            "owner",
+				"repo":             "repo",
+				"discussionNumber": float64(7),
+				"title":            "New title",
+				"category":         "DIC_kwDOA2",
+			},
+		},
+		{
+			name:         "no update parameters",
+			mockedClient: githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":            "owner",
+				"repo":             "repo",
+				"discussionNumber": float64(7),
+			},
+			expectError:    true,
+			expectedErrMsg: "No update parameters provided.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := UpdateDiscussion(stubGetGQLClientFn(githubv4.NewClient(tc.mockedClient)), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var returned MinimalDiscussionResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, 7, returned.Number)
+		})
+	}
+}
+
+func Test_CloseAndReopenDiscussion(t *testing.T) {
+	// Verify tool definitions once
+	closeTool, _ := CloseDiscussion(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(closeTool.Name, closeTool))
+	reopenTool, _ := ReopenDiscussion(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(reopenTool.Name, reopenTool))
+
+	assert.Equal(t, "close_discussion", closeTool.Name)
+	assert.Contains(t, closeTool.InputSchema.Properties, "reason")
+	assert.Equal(t, "reopen_discussion", reopenTool.Name)
+
+	args := map[string]any{
+		"owner":            "owner",
+		"repo":             "repo",
+		"discussionNumber": float64(7),
+		"reason":           "OUTDATED",
+	}
+
+	t.Run("close", func(t *testing.T) {
+		reason := githubv4.DiscussionCloseReasonOutdated
+		httpClient := githubv4mock.NewMockedHTTPClient(
+			discussionIDMatcher(),
+			githubv4mock.NewMutationMatcher(
+				struct {
+					CloseDiscussion struct {
+						Discussion DiscussionStateFragment
+					} `graphql:"closeDiscussion(input: $input)"`
+				}{},
+				githubv4.CloseDiscussionInput{
+					DiscussionID: githubv4.ID("D_kwDOA7"),
+					Reason:       &reason,
+				},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"closeDiscussion": map[string]any{"discussion": discussionState(true, false, false)},
+				}),
+			),
+		)
+		_, handler := CloseDiscussion(stubGetGQLClientFn(githubv4.NewClient(httpClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var returned MinimalDiscussionResponse
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		assert.True(t, returned.Closed)
+	})
+
+	t.Run("reopen", func(t *testing.T) {
+		httpClient := githubv4mock.NewMockedHTTPClient(
+			discussionIDMatcher(),
+			githubv4mock.NewMutationMatcher(
+				struct {
+					ReopenDiscussion struct {
+						Discussion DiscussionStateFragment
+					} `graphql:"reopenDiscussion(input: $input)"`
+				}{},
+				githubv4.ReopenDiscussionInput{DiscussionID: githubv4.ID("D_kwDOA7")},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"reopenDiscussion": map[string]any{"discussion": discussionState(false, false, false)},
+				}),
+			),
+		)
+		_, handler := ReopenDiscussion(stubGetGQLClientFn(githubv4.NewClient(httpClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var returned MinimalDiscussionResponse
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		assert.False(t, returned.Closed)
+	})
+
+	t.Run("discussion not found", func(t *testing.T) {
+		httpClient := githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(
+				DiscussionIDQuery{},
+				map[string]any{
+					"owner":            githubv4.String("owner"),
+					"repo":             githubv4.String("repo"),
+					"discussionNumber": githubv4.Int(7),
+				},
+				githubv4mock.ErrorResponse("Could not resolve to a Discussion with the number of 7."),
+			),
+		)
+		_, handler := CloseDiscussion(stubGetGQLClientFn(githubv4.NewClient(httpClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to find discussion")
+	})
+}
+
+func Test_LockDiscussion(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := LockDiscussion(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "lock_discussion", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "discussionNumber"})
+
+	lockReason := githubv4.LockReasonTooHeated
+	tests := []struct {
+		name           string
+		matcher        githubv4mock.Matcher
+		requestArgs    map[string]any
+		expectedLocked bool
+	}{
+		{
+			name: "lock",
+			matcher: githubv4mock.NewMutationMatcher(
+				struct {
+					LockLockable struct {
+						LockedRecord struct {
+							Discussion DiscussionStateFragment `graphql:"... on Discussion"`
+						}
+					} `graphql:"lockLockable(input: $input)"`
+				}{},
+				githubv4.LockLockableInput{
+					LockableID: githubv4.ID("D_kwDOA7"),
+					LockReason: &lockReason,
+				},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"lockLockable": map[string]any{"lockedRecord": discussionState(false, true, false)},
+				}),
+			),
+			requestArgs: map[string]any{
+				"owner":            "owner",
+				"repo":             "repo",
+				"discussionNumber": float64(7),
+				"reason":           "TOO_HEATED",
+			},
+			expectedLocked: true,
+		},
+		{
+			name: "unlock",
+			matcher: githubv4mock.NewMutationMatcher(
+				struct {
+					UnlockLockable struct {
+						UnlockedRecord struct {
+							Discussion DiscussionStateFragment `graphql:"... on Discussion"`
+						}
+					} `graphql:"unlockLockable(input: $input)"`
+				}{},
+				githubv4.UnlockLockableInput{LockableID: githubv4.ID("D_kwDOA7")},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"unlockLockable": map[string]any{"unlockedRecord": discussionState(false, false, false)},
+				}),
+			),
+			requestArgs: map[string]any{
+				"owner":            "owner",
+				"repo":             "repo",
+				"discussionNumber": float64(7),
+				"unlock":           true,
+			},
+			expectedLocked: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			httpClient := githubv4mock.NewMockedHTTPClient(discussionIDMatcher(), tc.matcher)
+			_, handler := LockDiscussion(stubGetGQLClientFn(githubv4.NewClient(httpClient)), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var returned MinimalDiscussionResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expectedLocked, returned.Locked)
+		})
+	}
+}
+
+// defaultDiscussionRepositoryMatcher matches the query for whether the .github repository of owner has discussions enabled.
+func defaultDiscussionRepositoryMatcher(enabled bool) githubv4mock.Matcher {
+	return githubv4mock.NewQueryMatcher(
+		DefaultDiscussionRepositoryQuery{},
+		map[string]any{
+			"owner": githubv4.String("owner"),
+			"name":  githubv4.String(".github"),
+		},
+		githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{"hasDiscussionsEnabled": enabled},
+		}),
+	)
+}
+
+// discussionRepositoriesMatcher matches the query for the repositories of owner with discussions enabled.
+func discussionRepositoriesMatcher(enabledRepos ...string) githubv4mock.Matcher {
+	repos := []map[string]any{{"name": "website", "hasDiscussionsEnabled": false}}
+	for _, name := range enabledRepos {
+		repos = append(repos, map[string]any{"name": name, "hasDiscussionsEnabled": true})
+	}
+	return githubv4mock.NewQueryMatcher(
+		DiscussionRepositoriesQuery{},
+		map[string]any{
+			"owner": githubv4.String("owner"),
+		},
+		githubv4mock.DataResponse(map[string]any{
+			"repositoryOwner": map[string]any{
+				"repositories": map[string]any{"nodes": repos},
+			},
+		}),
+	)
+}
+
+func Test_GetOrganizationDiscussionRepository(t *testing.T) {
+	defaultRepoNotFound := githubv4mock.NewQueryMatcher(
+		DefaultDiscussionRepositoryQuery{},
+		map[string]any{
+			"owner": githubv4.String("owner"),
+			"name":  githubv4.String(".github"),
+		},
+		githubv4mock.ErrorResponse("Could not resolve to a Repository with the name 'owner/.github'."),
+	)
+
+	tests := []struct {
+		name           string
+		matchers       []githubv4mock.Matcher
+		expectedRepo   string
+		expectedErrMsg string
+	}{
+		{
+			name:         ".github has discussions enabled",
+			matchers:     []githubv4mock.Matcher{defaultDiscussionRepositoryMatcher(true)},
+			expectedRepo: ".github",
+		},
+		{
+			name:         "single other repository has discussions enabled",
+			matchers:     []githubv4mock.Matcher{defaultDiscussionRepositoryMatcher(false), discussionRepositoriesMatcher("community")},
+			expectedRepo: "community",
+		},
+		{
+			name:         "no .github repository",
+			matchers:     []githubv4mock.Matcher{defaultRepoNotFound, discussionRepositoriesMatcher("community")},
+			expectedRepo: "community",
+		},
+		{
+			name:           "several repositories have discussions enabled",
+			matchers:       []githubv4mock.Matcher{defaultDiscussionRepositoryMatcher(false), discussionRepositoriesMatcher("community", "feedback")},
+			expectedErrMsg: "provide repo as one of: community, feedback",
+		},
+		{
+			name:           "no repository has discussions enabled",
+			matchers:       []githubv4mock.Matcher{defaultRepoNotFound, discussionRepositoriesMatcher()},
+			expectedErrMsg: "owner has no repository with discussions enabled",
+		},
+		{
+			name: "owner not accessible",
+			matchers: []githubv4mock.Matcher{githubv4mock.NewQueryMatcher(
+				DefaultDiscussionRepositoryQuery{},
+				map[string]any{
+					"owner": githubv4.String("owner"),
+					"name":  githubv4.String(".github"),
+				},
+				githubv4mock.ErrorResponse("Resource not accessible by integration"),
+			)},
+			expectedErrMsg: "failed to find the discussion repository of owner",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(tc.matchers...))
+
+			repo, err := getOrganizationDiscussionRepository(context.Background(), client, "owner")
+			if tc.expectedErrMsg != "" {
+				require.ErrorContains(t, err, tc.expectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedRepo, repo)
+		})
+	}
+}
+
+func Test_SearchDiscussions(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := SearchDiscussions(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "search_discussions", tool.Name)
+	assert.Contains(t, tool.InputSchema.Properties, "answered")
+	assert.Contains(t, tool.InputSchema.Properties, "category")
+	assert.Empty(t, tool.InputSchema.Required)
+
+	searchResponse := githubv4mock.DataResponse(map[string]any{
+		"search": map[string]any{
+			"discussionCount": 1,
+			"pageInfo": map[string]any{
+				"hasNextPage":     true,
+				"hasPreviousPage": false,
+				"startCursor":     "Y3Vyc29yOjE=",
+				"endCursor":       "Y3Vyc29yOjE=",
+			},
+			"nodes": []map[string]any{
+				{
+					"number":     12,
+					"title":      "How do I rotate tokens?",
+					"url":        "https://github.com/owner/community/discussions/12",
+					"closed":     false,
+					"isAnswered": false,
+					"createdAt":  "2025-01-02T03:04:05Z",
+					"updatedAt":  "2025-01-03T03:04:05Z",
+					"author":     map[string]any{"login": "octocat"},
+					"category":   map[string]any{"name": "Q&A"},
+					"repository": map[string]any{"nameWithOwner": "owner/community"},
+					"comments":   map[string]any{"totalCount": 3},
+				},
+			},
+		},
+	})
+
+	tests := []struct {
+		name           string
+		requestArgs    map[string]any
+		expectedQuery  string
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "unanswered discussions across an organisation",
+			requestArgs: map[string]any{
+				"owner":    "owner",
+				"answered": false,
+				"category": "Q&A",
+				"query":    "tokens",
+			},
+			expectedQuery: `user:owner is:unanswered category:"Q&A" tokens`,
+		},
+		{
+			name: "closed discussions in a repository",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "community",
+				"state": "closed",
+			},
+			expectedQuery: "repo:owner/community is:closed",
+		},
+		{
+			name:           "repo without owner",
+			requestArgs:    map[string]any{"repo": "community"},
+			expectError:    true,
+			expectedErrMsg: "owner is required when repo is provided",
+		},
+		{
+			name:           "nothing to search for",
+			requestArgs:    map[string]any{},
+			expectError:    true,
+			expectedErrMsg: "provide a query, an owner or at least one filter",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			httpClient := githubv4mock.NewMockedHTTPClient()
+			if !tc.expectError {
+				httpClient = githubv4mock.NewMockedHTTPClient(
+					githubv4mock.NewQueryMatcher(
+						SearchDiscussionsQuery{},
+						map[string]any{
+							"query": githubv4.String(tc.expectedQuery),
+							"first": githubv4.Int(30),
+							"after": (*githubv4.String)(nil),
+						},
+						searchResponse,
+					),
+				)
+			}
+			_, handler := SearchDiscussions(stubGetGQLClientFn(githubv4.NewClient(httpClient)), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response struct {
+				Discussions []MinimalDiscussion `json:"discussions"`
+				TotalCount  int                 `json:"totalCount"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, 1, response.TotalCount)
+			require.Len(t, response.Discussions, 1)
+			assert.Equal(t, MinimalDiscussion{
+				Number:     12,
+				Title:      "How do I rotate tokens?",
+				Repository: "owner/community",
+				Category:   "Q&A",
+				Author:     "octocat",
+				Comments:   3,
+				URL:        "https://github.com/owner/community/discussions/12",
+				CreatedAt:  "2025-01-02T03:04:05Z",
+				UpdatedAt:  "2025-01-03T03:04:05Z",
+			}, response.Discussions[0])
+		})
+	}
+}
@@ -0,0 +1,558 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	ghErrors "github.com/github/github-mcp-http/pkg/errors"
+	"github.com/github/github-mcp-http/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// MinimalTreeEntry is the output type for an entry of a git tree.
+type MinimalTreeEntry struct {
+	Path string `json:"path"`
+	Type string `json:"type"`
+	Mode string `json:"mode"`
+	SHA  string `json:"sha"`
+	// Size is only set for blobs.
+	Size int `json:"size,omitempty"`
+}
+
+// RepositoryTree is the output type of get_repository_tree.
+type RepositoryTree struct {
+	SHA     string             `json:"sha"`
+	Entries []MinimalTreeEntry `json:"entries"`
+	// Truncated is true when the tree has more entries than GitHub returns in a single response.
+	Truncated bool `json:"truncated"`
+}
+
+// GitBlob is the output type of get_blob.
+type GitBlob struct {
+	SHA  string `json:"sha"`
+	Size int    `json:"size"`
+	// Encoding is "utf-8" for text content and "base64" for binary content.
+	Encoding string `json:"encoding"`
+	Content  string `json:"content"`
+}
+
+// MinimalReference is the output type for a git reference.
+type MinimalReference struct {
+	Ref        string `json:"ref"`
+	SHA        string `json:"sha"`
+	ObjectType string `json:"object_type"`
+}
+
+// CreatedTag is the output type of create_tag.
+type CreatedTag struct {
+	Tag        string `json:"tag"`
+	Ref        string `json:"ref"`
+	SHA        string `json:"sha"`
+	ObjectSHA  string `json:"object_sha"`
+	ObjectType string `json:"object_type"`
+}
+
+func convertToMinimalReference(ref *github.Reference) MinimalReference {
+	return MinimalReference{
+		Ref:        ref.GetRef(),
+		SHA:        ref.GetObject().GetSHA(),
+		ObjectType: ref.GetObject().GetType(),
+	}
+}
+
+// qualifiedRef validates a ref given as heads/<branch> or tags/<tag>, with or without the refs/ prefix,
+// and returns it with the refs/ prefix.
+func qualifiedRef(ref string) (string, error) {
+	name := strings.TrimPrefix(ref, "refs/")
+	if !strings.HasPrefix(name, "heads/") && !strings.HasPrefix(name, "tags/") {
+		return "", fmt.Errorf("ref must be of the form heads/<branch> or tags/<tag>, got %q", ref)
+	}
+	return "refs/" + name, nil
+}
+
+// GetRepositoryTree creates a tool to list the entries of a git tree.
+func GetRepositoryTree(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_tree",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_TREE_DESCRIPTION", "List the files and directories of a GitHub repository as a git tree, with the SHA and size of each file. Use path_filter to limit the listing to a directory, and get_blob to read files by SHA.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPOSITORY_TREE_USER_TITLE", "Get repository tree"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("tree_sha",
+				mcp.Description("Tree SHA, commit SHA or branch name to list (defaults to the default branch)"),
+			),
+			mcp.WithBoolean("recursive",
+				mcp.Description("List the entries of subdirectories too (default: true)"),
+			),
+			mcp.WithString("path_filter",
+				mcp.Description("Only list entries under this directory, e.g. src/pkg"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			treeSHA, err := OptionalParam[string](request, "tree_sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			recursive, ok, err := OptionalParamOK[bool](request, "recursive")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !ok {
+				recursive = true
+			}
+			pathFilter, err := OptionalParam[string](request, "path_filter")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pathFilter = strings.Trim(pathFilter, "/")
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if treeSHA == "" {
+				repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				treeSHA = repository.GetDefaultBranch()
+			}
+
+			tree, resp, err := client.Git.GetTree(ctx, owner, repo, treeSHA, recursive)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get tree %s", treeSHA), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := RepositoryTree{
+				SHA:       tree.GetSHA(),
+				Entries:   []MinimalTreeEntry{},
+				Truncated: tree.GetTruncated(),
+			}
+			for _, entry := range tree.Entries {
+				if pathFilter != "" && !strings.HasPrefix(entry.GetPath(), pathFilter+"/") {
+					continue
+				}
+				result.Entries = append(result.Entries, MinimalTreeEntry{
+					Path: entry.GetPath(),
+					Type: entry.GetType(),
+					Mode: entry.GetMode(),
+					SHA:  entry.GetSHA(),
+					Size: entry.GetSize(),
+				})
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// GetBlob creates a tool to get the content of a git blob by SHA.
+func GetBlob(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_blob",
+			mcp.WithDescription(t("TOOL_GET_BLOB_DESCRIPTION", "Get the content of a file by its git blob SHA, as listed by get_repository_tree. Works for files up to 100 MB, above the limit of get_file_contents. Binary content is returned base64 encoded.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_BLOB_USER_TITLE", "Get blob"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("sha",
+				mcp.Required(),
+				mcp.Description("Blob SHA"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := RequiredParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			blob, resp, err := client.Git.GetBlob(ctx, owner, repo, sha)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get blob %s", sha), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := GitBlob{
+				SHA:      blob.GetSHA(),
+				Size:     blob.GetSize(),
+				Encoding: blob.GetEncoding(),
+				Content:  blob.GetContent(),
+			}
+			if result.Encoding == "base64" {
+				// The API wraps base64 content in lines; decode it to return text files as text.
+				content, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(result.Content, "\n", ""))
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to decode blob content: %v", err)), nil
+				}
+				if utf8.Valid(content) {
+					result.Encoding = "utf-8"
+					result.Content = string(content)
+				} else {
+					result.Content = base64.StdEncoding.EncodeToString(content)
+				}
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// ListRefs creates a tool to list the git references of a repository.
+func ListRefs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_refs",
+			mcp.WithDescription(t("TOOL_LIST_REFS_DESCRIPTION", "List the git references (branches and tags) of a GitHub repository, optionally only those starting with a prefix")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REFS_USER_TITLE", "List git references"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("prefix",
+				mcp.Description("Only list references starting with this prefix, e.g. heads/feature- or tags/v1."),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			prefix, err := OptionalParam[string](request, "prefix")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			refs, resp, err := client.Git.ListMatchingRefs(ctx, owner, repo, &github.ReferenceListOptions{
+				Ref: prefix,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list references", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]MinimalReference, 0, len(refs))
+			for _, ref := range refs {
+				result = append(result, convertToMinimalReference(ref))
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// UpdateRef creates a tool to point a git reference at another commit.
+func UpdateRef(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_ref",
+			mcp.WithDescription(t("TOOL_UPDATE_REF_DESCRIPTION", "Point a branch or tag of a GitHub repository at another commit. Without force, the update must be a fast-forward.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_UPDATE_REF_USER_TITLE", "Update git reference"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Required(),
+				mcp.Description("Reference to update, e.g. heads/main or tags/v1.2.0"),
+			),
+			mcp.WithString("sha",
+				mcp.Required(),
+				mcp.Description("SHA to point the reference at"),
+			),
+			mcp.WithBoolean("force",
+				mcp.Description("Allow updates that are not fast-forwards, discarding commits (default: false)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			refName, err := RequiredParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := RequiredParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			force, err := OptionalParam[bool](request, "force")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			refName, err = qualifiedRef(refName)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			ref, resp, err := client.Git.UpdateRef(ctx, owner, repo, &github.Reference{
+				Ref:    github.Ptr(refName),
+				Object: &github.GitObject{SHA: github.Ptr(sha)},
+			}, force)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to update %s", refName), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(convertToMinimalReference(ref)), nil
+		}
+}
+
+// DeleteRef creates a tool to delete a git reference.
+func DeleteRef(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_ref",
+			mcp.WithDescription(t("TOOL_DELETE_REF_DESCRIPTION", "Delete a branch or tag of a GitHub repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_DELETE_REF_USER_TITLE", "Delete git reference"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Required(),
+				mcp.Description("Reference to delete, e.g. heads/feature or tags/v1.2.0"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			refName, err := RequiredParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			refName, err = qualifiedRef(refName)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Git.DeleteRef(ctx, owner, repo, refName)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to delete %s", refName), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Deleted %s", refName)), nil
+		}
+}
+
+// CreateTag creates a tool to create an annotated tag.
+func CreateTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_tag",
+			mcp.WithDescription(t("TOOL_CREATE_TAG_DESCRIPTION", "Create an annotated tag in a GitHub repository: a tag object with a message, and the tags/<tag> reference pointing at it")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_TAG_USER_TITLE", "Create annotated tag"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("tag",
+				mcp.Required(),
+				mcp.Description("Tag name, e.g. v1.2.0"),
+			),
+			mcp.WithString("message",
+				mcp.Required(),
+				mcp.Description("Tag message"),
+			),
+			mcp.WithString("sha",
+				mcp.Required(),
+				mcp.Description("SHA of the object to tag"),
+			),
+			mcp.WithString("object_type",
+				mcp.Description("Type of the object to tag (default: commit)"),
+				mcp.Enum("commit", "tree", "blob"),
+			),
+			mcp.WithString("tagger_name",
+				mcp.Description("Name of the tagger (defaults to the authenticated user)"),
+			),
+			mcp.WithString("tagger_email",
+				mcp.Description("Email of the tagger, required with tagger_name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			tagName, err := RequiredParam[string](request, "tag")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			message, err := RequiredParam[string](request, "message")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := RequiredParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			objectType, err := OptionalParam[string](request, "object_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if objectType == "" {
+				objectType = "commit"
+			}
+			taggerName, err := OptionalParam[string](request, "tagger_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			taggerEmail, err := OptionalParam[string](request, "tagger_email")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if (taggerName == "") != (taggerEmail == "") {
+				return mcp.NewToolResultError("tagger_name and tagger_email must be given together"), nil
+			}
+			tagName = strings.TrimPrefix(strings.TrimPrefix(tagName, "refs/"), "tags/")
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			tag := &github.Tag{
+				Tag:     github.Ptr(tagName),
+				Message: github.Ptr(message),
+				Object: &github.GitObject{
+					SHA:  github.Ptr(sha),
+					Type: github.Ptr(objectType),
+				},
+			}
+			if taggerName != "" {
+				tag.Tagger = &github.CommitAuthor{
+					Name:  github.Ptr(taggerName),
+					Email: github.Ptr(taggerEmail),
+				}
+			}
+			createdTag, resp, err := client.Git.CreateTag(ctx, owner, repo, tag)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create tag object", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			// The tag object is not reachable until a reference points at it.
+			ref, resp, err := client.Git.CreateRef(ctx, owner, repo, &github.Reference{
+				Ref:    github.Ptr("refs/tags/" + tagName),
+				Object: &github.GitObject{SHA: createdTag.SHA},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to create reference for tag %s", tagName), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(CreatedTag{
+				Tag:        createdTag.GetTag(),
+				Ref:        ref.GetRef(),
+				SHA:        createdTag.GetSHA(),
+				ObjectSHA:  createdTag.GetObject().GetSHA(),
+				ObjectType: createdTag.GetObject().GetType(),
+			}), nil
+		}
+}
@@ -0,0 +1,639 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-http/internal/toolsnaps"
+	"github.com/github/github-mcp-http/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetRepositoryTree(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositoryTree(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_repository_tree", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "tree_sha")
+	assert.Contains(t, tool.InputSchema.Properties, "recursive")
+	assert.Contains(t, tool.InputSchema.Properties, "path_filter")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockTree := &github.Tree{
+		SHA: github.Ptr("tree123"),
+		Entries: []*github.TreeEntry{
+			{Path: github.Ptr("README.md"), Type: github.Ptr("blob"), Mode: github.Ptr("100644"), SHA: github.Ptr("blob1"), Size: github.Ptr(120)},
+			{Path: github.Ptr("src"), Type: github.Ptr("tree"), Mode: github.Ptr("040000"), SHA: github.Ptr("tree1")},
+			{Path: github.Ptr("src/main.go"), Type: github.Ptr("blob"), Mode: github.Ptr("100644"), SHA: github.Ptr("blob2"), Size: github.Ptr(2048)},
+			{Path: github.Ptr("srcgen/gen.go"), Type: github.Ptr("blob"), Mode: github.Ptr("100644"), SHA: github.Ptr("blob3"), Size: github.Ptr(64)},
+		},
+		Truncated: github.Ptr(false),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectError    bool
+		expectedResult RepositoryTree
+		expectedErrMsg string
+	}{
+		{
+			name: "recursive tree of the default branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					&github.Repository{DefaultBranch: github.Ptr("main")},
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitTreesByOwnerByRepoByTreeSha,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/git/trees/main",
+						queryParams: map[string]string{"recursive": "1"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockTree),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedResult: RepositoryTree{
+				SHA: "tree123",
+				Entries: []MinimalTreeEntry{
+					{Path: "README.md", Type: "blob", Mode: "100644", SHA: "blob1", Size: 120},
+					{Path: "src", Type: "tree", Mode: "040000", SHA: "tree1"},
+					{Path: "src/main.go", Type: "blob", Mode: "100644", SHA: "blob2", Size: 2048},
+					{Path: "srcgen/gen.go", Type: "blob", Mode: "100644", SHA: "blob3", Size: 64},
+				},
+			},
+		},
+		{
+			name: "filtered by path",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitTreesByOwnerByRepoByTreeSha,
+					expectPath(t, "/repos/owner/repo/git/trees/v1.2.0").andThen(
+						mockResponse(t, http.StatusOK, mockTree),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"tree_sha":    "v1.2.0",
+				"path_filter": "/src/",
+			},
+			expectedResult: RepositoryTree{
+				SHA: "tree123",
+				Entries: []MinimalTreeEntry{
+					{Path: "src/main.go", Type: "blob", Mode: "100644", SHA: "blob2", Size: 2048},
+				},
+			},
+		},
+		{
+			name: "tree not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitTreesByOwnerByRepoByTreeSha,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"tree_sha": "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get tree missing",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepositoryTree(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned RepositoryTree
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_GetBlob(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetBlob(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_blob", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "sha"})
+
+	binaryContent := []byte{0x89, 0x50, 0x4e, 0x47, 0xff, 0xfe}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedResult GitBlob
+		expectedErrMsg string
+	}{
+		{
+			name: "text blob",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitBlobsByOwnerByRepoByFileSha,
+					expectPath(t, "/repos/owner/repo/git/blobs/abc123").andThen(
+						mockResponse(t, http.StatusOK, &github.Blob{
+							SHA:      github.Ptr("abc123"),
+							Size:     github.Ptr(12),
+							Encoding: github.Ptr("base64"),
+							// The API wraps base64 content in lines of 60 characters.
+							Content: github.Ptr("aGVsbG8g\nd29ybGQK\n"),
+						}),
+					),
+				),
+			),
+			expectedResult: GitBlob{SHA: "abc123", Size: 12, Encoding: "utf-8", Content: "hello world\n"},
+		},
+		{
+			name: "binary blob",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitBlobsByOwnerByRepoByFileSha,
+					&github.Blob{
+						SHA:      github.Ptr("abc123"),
+						Size:     github.Ptr(len(binaryContent)),
+						Encoding: github.Ptr("base64"),
+						Content:  github.Ptr(base64.StdEncoding.EncodeToString(binaryContent) + "\n"),
+					},
+				),
+			),
+			expectedResult: GitBlob{SHA: "abc123", Size: 6, Encoding: "base64", Content: base64.StdEncoding.EncodeToString(binaryContent)},
+		},
+		{
+			name: "malformed base64 content",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitBlobsByOwnerByRepoByFileSha,
+					&github.Blob{
+						SHA:      github.Ptr("abc123"),
+						Size:     github.Ptr(3),
+						Encoding: github.Ptr("base64"),
+						Content:  github.Ptr("not base64!"),
+					},
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to decode blob content",
+		},
+		{
+			name: "blob not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitBlobsByOwnerByRepoByFileSha,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get blob abc123",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetBlob(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned GitBlob
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_ListRefs(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRefs(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_refs", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "prefix")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockRefs := []*github.Reference{
+		{Ref: github.Ptr("refs/tags/v1.0.0"), Object: &github.GitObject{SHA: github.Ptr("tag100"), Type: github.Ptr("tag")}},
+		{Ref: github.Ptr("refs/tags/v1.1.0"), Object: &github.GitObject{SHA: github.Ptr("commit110"), Type: github.Ptr("commit")}},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectError    bool
+		expectedResult []MinimalReference
+		expectedErrMsg string
+	}{
+		{
+			name: "refs with a prefix",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitMatchingRefsByOwnerByRepoByRef,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/git/matching-refs/tags",
+						queryParams: map[string]string{"page": "2", "per_page": "10"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockRefs),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"prefix":  "refs/tags",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectedResult: []MinimalReference{
+				{Ref: "refs/tags/v1.0.0", SHA: "tag100", ObjectType: "tag"},
+				{Ref: "refs/tags/v1.1.0", SHA: "commit110", ObjectType: "commit"},
+			},
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitMatchingRefsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			args: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list references",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListRefs(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned []MinimalReference
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_UpdateRef(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateRef(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_ref", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.Contains(t, tool.InputSchema.Properties, "force")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ref", "sha"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectError    bool
+		expectedResult MinimalReference
+		expectedErrMsg string
+	}{
+		{
+			name: "force update a branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposGitRefsByOwnerByRepoByRef,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/git/refs/heads/feature",
+						requestBody: map[string]any{"sha": "def456", "force": true},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Reference{
+							Ref:    github.Ptr("refs/heads/feature"),
+							Object: &github.GitObject{SHA: github.Ptr("def456"), Type: github.Ptr("commit")},
+						}),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "heads/feature",
+				"sha":   "def456",
+				"force": true,
+			},
+			expectedResult: MinimalReference{Ref: "refs/heads/feature", SHA: "def456", ObjectType: "commit"},
+		},
+		{
+			name: "update that is not a fast-forward",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposGitRefsByOwnerByRepoByRef,
+					expectRequestBody(t, map[string]any{"sha": "def456", "force": false}).andThen(
+						mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Update is not a fast forward"}),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "refs/heads/feature",
+				"sha":   "def456",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update refs/heads/feature",
+		},
+		{
+			name:         "branch name without heads/",
+			mockedClient: mock.NewMockedHTTPClient(),
+			args: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "feature",
+				"sha":   "def456",
+			},
+			expectError:    true,
+			expectedErrMsg: "ref must be of the form heads/<branch> or tags/<tag>",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateRef(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned MinimalReference
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_DeleteRef(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteRef(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "delete_ref", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ref"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		ref            string
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "delete a tag",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposGitRefsByOwnerByRepoByRef,
+					expectPath(t, "/repos/owner/repo/git/refs/tags/v1.2.0").andThen(
+						mockResponse(t, http.StatusNoContent, nil),
+					),
+				),
+			),
+			ref:          "tags/v1.2.0",
+			expectedText: "Deleted refs/tags/v1.2.0",
+		},
+		{
+			name: "ref not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposGitRefsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Reference does not exist"}),
+				),
+			),
+			ref:            "heads/missing",
+			expectError:    true,
+			expectedErrMsg: "failed to delete refs/heads/missing",
+		},
+		{
+			name:           "ref that is not a branch or tag",
+			mockedClient:   mock.NewMockedHTTPClient(),
+			ref:            "pull/42/head",
+			expectError:    true,
+			expectedErrMsg: "ref must be of the form heads/<branch> or tags/<tag>",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DeleteRef(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   tc.ref,
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_CreateTag(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateTag(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_tag", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "object_type")
+	assert.Contains(t, tool.InputSchema.Properties, "tagger_name")
+	assert.Contains(t, tool.InputSchema.Properties, "tagger_email")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "tag", "message", "sha"})
+
+	mockTag := &github.Tag{
+		Tag:     github.Ptr("v1.2.0"),
+		SHA:     github.Ptr("tag123"),
+		Message: github.Ptr("Release v1.2.0"),
+		Object:  &github.GitObject{SHA: github.Ptr("abc123"), Type: github.Ptr("commit")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectError    bool
+		expectedResult CreatedTag
+		expectedErrMsg string
+	}{
+		{
+			name: "annotated tag with a tagger",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitTagsByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"tag":     "v1.2.0",
+						"message": "Release v1.2.0",
+						"object":  "abc123",
+						"type":    "commit",
+						"tagger":  map[string]any{"name": "Release Bot", "email": "release@example.com"},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockTag),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitRefsByOwnerByRepo,
+					expectRequestBody(t, map[string]any{"ref": "refs/tags/v1.2.0", "sha": "tag123"}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Reference{
+							Ref:    github.Ptr("refs/tags/v1.2.0"),
+							Object: &github.GitObject{SHA: github.Ptr("tag123"), Type: github.Ptr("tag")},
+						}),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"tag":          "v1.2.0",
+				"message":      "Release v1.2.0",
+				"sha":          "abc123",
+				"tagger_name":  "Release Bot",
+				"tagger_email": "release@example.com",
+			},
+			expectedResult: CreatedTag{Tag: "v1.2.0", Ref: "refs/tags/v1.2.0", SHA: "tag123", ObjectSHA: "abc123", ObjectType: "commit"},
+		},
+		{
+			name: "tag already exists",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.PostReposGitTagsByOwnerByRepo,
+					mockTag,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitRefsByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Reference already exists"}),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"tag":     "refs/tags/v1.2.0",
+				"message": "Release v1.2.0",
+				"sha":     "abc123",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create reference for tag v1.2.0",
+		},
+		{
+			name:         "tagger name without email",
+			mockedClient: mock.NewMockedHTTPClient(),
+			args: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"tag":         "v1.2.0",
+				"message":     "Release v1.2.0",
+				"sha":         "abc123",
+				"tagger_name": "Release Bot",
+			},
+			expectError:    true,
+			expectedErrMsg: "tagger_name and tagger_email must be given together",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateTag(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned CreatedTag
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
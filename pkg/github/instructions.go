@@ -52,7 +52,7 @@ func getToolsetInstructions(toolset string) string {
 	case "issues":
 		return "## Issues\n\nCheck 'list_issue_types' first for organizations to use proper issue types. Use 'search_issues' before creating new issues to avoid duplicates. Always set 'state_reason' when closing issues."
 	case "discussions":
-		return "## Discussions\n\nUse 'list_discussion_categories' to understand available categories before creating discussions. Filter by category for better organization."
+		return "## Discussions\n\nUse 'list_discussion_categories' to understand available categories before creating discussions. Filter by category for better organization. Use 'search_discussions' to find discussions across all repositories of an organization."
 	default:
 		return ""
 	}
@@ -1511,6 +1511,251 @@ func GetIssueComments(getClient GetClientFn, t translations.TranslationHelperFun
 		}
 }
 
+// GetIssueTimeline creates a tool to get the timeline of events of an issue.
+func GetIssueTimeline(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_issue_timeline",
+			mcp.WithDescription(t("TOOL_GET_ISSUE_TIMELINE_DESCRIPTION", "Get the timeline of an issue, oldest first: who closed or reopened it and with which commit, label, assignee and milestone changes, renames, cross-references from other issues and pull requests, and manually connected pull requests.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_ISSUE_TIMELINE_USER_TITLE", "Get issue timeline"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number"),
+			),
+			mcp.WithArray("event_types",
+				mcp.Description("Only return events of these types, e.g. closed, reopened, labeled, unlabeled, assigned, milestoned, renamed, cross-referenced, referenced, connected, commented. The filter is applied to each page, so a page can hold fewer events than perPage"),
+				mcp.Items(map[string]any{
+					"type": "string",
+				}),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			eventTypes, err := OptionalStringArrayParam(request, "event_types")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			events, resp, err := client.Issues.ListIssueTimeline(ctx, owner, repo, issueNumber, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get issue timeline",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			wanted := make(map[string]bool, len(eventTypes))
+			for _, eventType := range eventTypes {
+				wanted[eventType] = true
+			}
+
+			minimalEvents := []MinimalTimelineEvent{}
+			for _, event := range events {
+				if len(wanted) > 0 && !wanted[event.GetEvent()] {
+					continue
+				}
+				minimalEvents = append(minimalEvents, convertToMinimalTimelineEvent(event))
+			}
+
+			return MarshalledTextResult(minimalEvents), nil
+		}
+}
+
+// LinkedPullRequestFragment is a pull request that references an issue, as returned by the GraphQL API.
+type LinkedPullRequestFragment struct {
+	Number  githubv4.Int
+	Title   githubv4.String
+	URL     githubv4.URI
+	State   githubv4.PullRequestState
+	IsDraft githubv4.Boolean
+	Author  struct {
+		Login githubv4.String
+	}
+	Repository struct {
+		NameWithOwner githubv4.String
+	}
+}
+
+// GetLinkedPullRequestsQuery is the GraphQL query for the pull requests that close or mention an issue.
+type GetLinkedPullRequestsQuery struct {
+	Repository struct {
+		Issue struct {
+			ClosedByPullRequestsReferences struct {
+				TotalCount githubv4.Int
+				Nodes      []LinkedPullRequestFragment
+			} `graphql:"closedByPullRequestsReferences(first: 25, includeClosedPrs: true)"`
+			TimelineItems struct {
+				TotalCount githubv4.Int
+				Nodes      []struct {
+					CrossReferencedEvent struct {
+						CreatedAt       githubv4.DateTime
+						WillCloseTarget githubv4.Boolean
+						Actor           struct {
+							Login githubv4.String
+						}
+						Source struct {
+							PullRequest LinkedPullRequestFragment `graphql:"... on PullRequest"`
+						}
+					} `graphql:"... on CrossReferencedEvent"`
+				}
+			} `graphql:"timelineItems(first: 100, itemTypes: [CROSS_REFERENCED_EVENT])"`
+		} `graphql:"issue(number: $issueNumber)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// LinkedPullRequest is the output type for a pull request linked to an issue.
+type LinkedPullRequest struct {
+	Repository string `json:"repository"`
+	Number     int    `json:"number"`
+	Title      string `json:"title"`
+	URL        string `json:"url"`
+	State      string `json:"state"`
+	IsDraft    bool   `json:"isDraft"`
+	Author     string `json:"author,omitempty"`
+	// WillClose is true when merging the pull request closes the issue.
+	WillClose    bool   `json:"willClose"`
+	ReferencedAt string `json:"referencedAt,omitempty"`
+	ReferencedBy string `json:"referencedBy,omitempty"`
+}
+
+// LinkedPullRequestsResult is the output type of get_linked_pull_requests.
+type LinkedPullRequestsResult struct {
+	PullRequests []LinkedPullRequest `json:"pullRequests"`
+	// Truncated is true when the issue has more closing references or cross-references than were fetched.
+	Truncated bool `json:"truncated"`
+}
+
+// GetLinkedPullRequests creates a tool to list the pull requests that close or mention an issue.
+func GetLinkedPullRequests(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_linked_pull_requests",
+			mcp.WithDescription(t("TOOL_GET_LINKED_PULL_REQUESTS_DESCRIPTION", "Get the pull requests linked to an issue: pull requests that will close it when merged, and pull requests that mention it. Pull requests that close the issue are listed first.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_LINKED_PULL_REQUESTS_USER_TITLE", "Get pull requests linked to an issue"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			var query GetLinkedPullRequestsQuery
+			if err := client.Query(ctx, &query, map[string]any{
+				"owner":       githubv4.String(owner),
+				"repo":        githubv4.String(repo),
+				"issueNumber": githubv4.Int(issueNumber), // #nosec G115 - issue numbers are always small positive integers
+			}); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get linked pull requests", err), nil
+			}
+
+			issue := query.Repository.Issue
+			result := LinkedPullRequestsResult{
+				PullRequests: []LinkedPullRequest{},
+				Truncated: int(issue.ClosedByPullRequestsReferences.TotalCount) > len(issue.ClosedByPullRequestsReferences.Nodes) ||
+					int(issue.TimelineItems.TotalCount) > len(issue.TimelineItems.Nodes),
+			}
+
+			// A pull request can both close the issue and mention it several times; report it once.
+			indexByKey := map[string]int{}
+			add := func(fragment LinkedPullRequestFragment) *LinkedPullRequest {
+				key := fmt.Sprintf("%s#%d", fragment.Repository.NameWithOwner, fragment.Number)
+				if i, ok := indexByKey[key]; ok {
+					return &result.PullRequests[i]
+				}
+				indexByKey[key] = len(result.PullRequests)
+				result.PullRequests = append(result.PullRequests, LinkedPullRequest{
+					Repository: string(fragment.Repository.NameWithOwner),
+					Number:     int(fragment.Number),
+					Title:      string(fragment.Title),
+					URL:        fragment.URL.String(),
+					State:      string(fragment.State),
+					IsDraft:    bool(fragment.IsDraft),
+					Author:     string(fragment.Author.Login),
+				})
+				return &result.PullRequests[len(result.PullRequests)-1]
+			}
+
+			for _, pr := range issue.ClosedByPullRequestsReferences.Nodes {
+				add(pr).WillClose = true
+			}
+			for _, node := range issue.TimelineItems.Nodes {
+				event := node.CrossReferencedEvent
+				if event.Source.PullRequest.Number == 0 {
+					// The issue was referenced from another issue rather than a pull request.
+					continue
+				}
+				linked := add(event.Source.PullRequest)
+				linked.WillClose = linked.WillClose || bool(event.WillCloseTarget)
+				if linked.ReferencedAt == "" {
+					linked.ReferencedAt = event.CreatedAt.Format("2006-01-02T15:04:05Z")
+					linked.ReferencedBy = string(event.Actor.Login)
+				}
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
 // mvpDescription is an MVP idea for generating tool descriptions from structured data in a shared format.
 // It is not intended for widespread usage and is not a complete implementation.
 type mvpDescription struct {
@@ -3224,3 +3224,272 @@ func Test_ListIssueTypes(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetIssueTimeline(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetIssueTimeline(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_issue_timeline", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "event_types")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	mockEvents := []*github.Timeline{
+		{
+			Event: github.Ptr("labeled"),
+			Actor: &github.User{Login: github.Ptr("octocat")},
+			Label: &github.Label{Name: github.Ptr("bug")},
+		},
+		{
+			Event: github.Ptr("cross-referenced"),
+			Source: &github.Source{
+				Issue: &github.Issue{
+					Number:           github.Ptr(43),
+					Title:            github.Ptr("Fix the bug"),
+					PullRequestLinks: &github.PullRequestLinks{URL: github.Ptr("https://api.github.com/repos/owner/repo/pulls/43")},
+					Repository:       &github.Repository{FullName: github.Ptr("owner/repo")},
+				},
+			},
+		},
+		{
+			Event:    github.Ptr("closed"),
+			Actor:    &github.User{Login: github.Ptr("octocat")},
+			CommitID: github.Ptr("abc123"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedEvents []string
+	}{
+		{
+			name: "all events",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesTimelineByOwnerByRepoByIssueNumber,
+					expect(t, expectations{
+						path: "/repos/owner/repo/issues/42/timeline",
+						queryParams: map[string]string{
+							"page":     "2",
+							"per_page": "50",
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockEvents),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"page":         float64(2),
+				"perPage":      float64(50),
+			},
+			expectedEvents: []string{"labeled", "cross-referenced", "closed"},
+		},
+		{
+			name: "filtered by event type",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesTimelineByOwnerByRepoByIssueNumber,
+					mockEvents,
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"event_types":  []any{"closed", "cross-referenced"},
+			},
+			expectedEvents: []string{"cross-referenced", "closed"},
+		},
+		{
+			name: "timeline fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesTimelineByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get issue timeline",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetIssueTimeline(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var returned []MinimalTimelineEvent
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+
+			var events []string
+			for _, event := range returned {
+				events = append(events, event.Event)
+				switch event.Event {
+				case "cross-referenced":
+					require.NotNil(t, event.Source)
+					assert.Equal(t, 43, event.Source.Number)
+					assert.True(t, event.Source.PullRequest)
+					assert.Equal(t, "owner/repo", event.Source.Repository)
+				case "closed":
+					assert.Equal(t, "abc123", event.CommitID)
+				}
+			}
+			assert.Equal(t, tc.expectedEvents, events)
+		})
+	}
+}
+
+func Test_GetLinkedPullRequests(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := GetLinkedPullRequests(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_linked_pull_requests", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	vars := map[string]any{
+		"owner":       githubv4.String("owner"),
+		"repo":        githubv4.String("repo"),
+		"issueNumber": githubv4.Int(42),
+	}
+	pullRequest := func(number int, title, state string) map[string]any {
+		return map[string]any{
+			"number":     number,
+			"title":      title,
+			"url":        fmt.Sprintf("https://github.com/owner/repo/pull/%d", number),
+			"state":      state,
+			"isDraft":    false,
+			"author":     map[string]any{"login": "octocat"},
+			"repository": map[string]any{"nameWithOwner": "owner/repo"},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		response       githubv4mock.GQLResponse
+		expectError    bool
+		expectedErrMsg string
+		expected       LinkedPullRequestsResult
+	}{
+		{
+			name: "closing and mentioning pull requests",
+			response: githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"issue": map[string]any{
+						"closedByPullRequestsReferences": map[string]any{
+							"totalCount": 1,
+							"nodes":      []any{pullRequest(43, "Fix the bug", "OPEN")},
+						},
+						"timelineItems": map[string]any{
+							"totalCount": 3,
+							"nodes": []any{
+								map[string]any{
+									"createdAt":       "2025-01-02T03:04:05Z",
+									"willCloseTarget": true,
+									"actor":           map[string]any{"login": "octocat"},
+									"source":          pullRequest(43, "Fix the bug", "OPEN"),
+								},
+								map[string]any{
+									"createdAt":       "2025-01-03T03:04:05Z",
+									"willCloseTarget": false,
+									"actor":           map[string]any{"login": "hubot"},
+									"source":          map[string]any{},
+								},
+								map[string]any{
+									"createdAt":       "2025-01-04T03:04:05Z",
+									"willCloseTarget": false,
+									"actor":           map[string]any{"login": "hubot"},
+									"source":          pullRequest(44, "Related refactor", "MERGED"),
+								},
+							},
+						},
+					},
+				},
+			}),
+			expected: LinkedPullRequestsResult{
+				PullRequests: []LinkedPullRequest{
+					{
+						Repository:   "owner/repo",
+						Number:       43,
+						Title:        "Fix the bug",
+						URL:          "https://github.com/owner/repo/pull/43",
+						State:        "OPEN",
+						Author:       "octocat",
+						WillClose:    true,
+						ReferencedAt: "2025-01-02T03:04:05Z",
+						ReferencedBy: "octocat",
+					},
+					{
+						Repository:   "owner/repo",
+						Number:       44,
+						Title:        "Related refactor",
+						URL:          "https://github.com/owner/repo/pull/44",
+						State:        "MERGED",
+						Author:       "octocat",
+						ReferencedAt: "2025-01-04T03:04:05Z",
+						ReferencedBy: "hubot",
+					},
+				},
+			},
+		},
+		{
+			name:           "issue not found",
+			response:       githubv4mock.ErrorResponse("Could not resolve to an Issue with the number of 42."),
+			expectError:    true,
+			expectedErrMsg: "failed to get linked pull requests",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockedClient := githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(GetLinkedPullRequestsQuery{}, vars, tc.response),
+			)
+			_, handler := GetLinkedPullRequests(stubGetGQLClientFn(githubv4.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var returned LinkedPullRequestsResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
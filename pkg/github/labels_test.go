@@ -0,0 +1,490 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-http/internal/toolsnaps"
+	"github.com/github/github-mcp-http/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListLabels(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListLabels(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_labels", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	labels := []*github.Label{
+		{Name: github.Ptr("bug"), Color: github.Ptr("d73a4a"), Description: github.Ptr("Something isn't working"), Default: github.Ptr(true)},
+		{Name: github.Ptr("triage"), Color: github.Ptr("ededed")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful list",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposLabelsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, labels),
+					),
+				),
+			),
+		},
+		{
+			name: "list fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposLabelsByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to list labels",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListLabels(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var returned []MinimalLabel
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, []MinimalLabel{
+				{Name: "bug", Color: "d73a4a", Description: "Something isn't working", Default: true},
+				{Name: "triage", Color: "ededed"},
+			}, returned)
+		})
+	}
+}
+
+func Test_CreateLabel(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateLabel(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_label", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "name", "color"})
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposLabelsByOwnerByRepo,
+			expectRequestBody(t, map[string]any{
+				"name":        "needs-review",
+				"color":       "0e8a16",
+				"description": "Waiting for a reviewer",
+			}).andThen(
+				mockResponse(t, http.StatusCreated, &github.Label{
+					Name:        github.Ptr("needs-review"),
+					Color:       github.Ptr("0e8a16"),
+					Description: github.Ptr("Waiting for a reviewer"),
+				}),
+			),
+		),
+	)
+
+	client := github.NewClient(mockedClient)
+	_, handler := CreateLabel(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":       "owner",
+		"repo":        "repo",
+		"name":        "needs-review",
+		"color":       "#0e8a16",
+		"description": "Waiting for a reviewer",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned MinimalLabel
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, "needs-review", returned.Name)
+	assert.Equal(t, "0e8a16", returned.Color)
+}
+
+// Label names can contain slashes, which the mocked routes do not match once the escaped name is decoded.
+var (
+	patchReposLabelsByOwnerByRepoBySlashedName = mock.EndpointPattern{
+		Pattern: "/repos/{owner}/{repo}/labels/{name:.+}",
+		Method:  "PATCH",
+	}
+	deleteReposLabelsByOwnerByRepoBySlashedName = mock.EndpointPattern{
+		Pattern: "/repos/{owner}/{repo}/labels/{name:.+}",
+		Method:  "DELETE",
+	}
+	deleteReposIssuesLabelsByOwnerByRepoByIssueNumberBySlashedName = mock.EndpointPattern{
+		Pattern: "/repos/{owner}/{repo}/issues/{issue_number}/labels/{name:.+}",
+		Method:  "DELETE",
+	}
+)
+
+func Test_UpdateLabel(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateLabel(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_label", tool.Name)
+	assert.Contains(t, tool.InputSchema.Properties, "new_name")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "name"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "rename label",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposLabelsByOwnerByRepoByName,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/labels/bug",
+						requestBody: map[string]any{"name": "type: bug", "color": "b60205"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Label{
+							Name:  github.Ptr("type: bug"),
+							Color: github.Ptr("b60205"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"name":     "bug",
+				"new_name": "type: bug",
+				"color":    "b60205",
+			},
+		},
+		{
+			name: "label name with a slash",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					patchReposLabelsByOwnerByRepoBySlashedName,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/labels/kind%2Fbug", r.URL.EscapedPath())
+						mockResponse(t, http.StatusOK, &github.Label{
+							Name:  github.Ptr("type: bug"),
+							Color: github.Ptr("d73a4a"),
+						})(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"name":     "kind/bug",
+				"new_name": "type: bug",
+			},
+		},
+		{
+			name:         "no update parameters",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"name":  "bug",
+			},
+			expectError:    true,
+			expectedErrMsg: "No update parameters provided.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateLabel(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var returned MinimalLabel
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, "type: bug", returned.Name)
+		})
+	}
+}
+
+func Test_DeleteLabel(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteLabel(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "delete_label", tool.Name)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "name"})
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteReposLabelsByOwnerByRepoByName,
+			expectPath(t, "/repos/owner/repo/labels/wontfix").andThen(
+				mockResponse(t, http.StatusNoContent, nil),
+			),
+		),
+	)
+
+	client := github.NewClient(mockedClient)
+	_, handler := DeleteLabel(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner": "owner",
+		"repo":  "repo",
+		"name":  "wontfix",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Equal(t, `label "wontfix" deleted`, getTextResult(t, result).Text)
+}
+
+func Test_DeleteLabel_EscapesName(t *testing.T) {
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			deleteReposLabelsByOwnerByRepoBySlashedName,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/repos/owner/repo/labels/area%2Fbackend", r.URL.EscapedPath())
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		),
+	))
+	_, handler := DeleteLabel(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner": "owner",
+		"repo":  "repo",
+		"name":  "area/backend",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Equal(t, `label "area/backend" deleted`, getTextResult(t, result).Text)
+}
+
+func Test_AddLabelsToIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AddLabelsToIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "add_labels_to_issue", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "labels"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		labels         []any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "adds labels to existing set",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesLabelsByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, []any{"triage"}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Label{
+							{Name: github.Ptr("bug"), Color: github.Ptr("d73a4a")},
+							{Name: github.Ptr("triage"), Color: github.Ptr("ededed")},
+						}),
+					),
+				),
+			),
+			labels: []any{"triage"},
+		},
+		{
+			name:           "empty labels",
+			mockedClient:   mock.NewMockedHTTPClient(),
+			labels:         []any{},
+			expectError:    true,
+			expectedErrMsg: "labels must contain at least one label name",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AddLabelsToIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"labels":       tc.labels,
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var returned []MinimalLabel
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			require.Len(t, returned, 2)
+			assert.Equal(t, "bug", returned[0].Name)
+			assert.Equal(t, "triage", returned[1].Name)
+		})
+	}
+}
+
+func Test_RemoveLabelsFromIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RemoveLabelsFromIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "remove_labels_from_issue", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "labels"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		labels         []any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "removes labels and ignores labels the issue does not have",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposIssuesLabelsByOwnerByRepoByIssueNumberByName,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.URL.Path == "/repos/owner/repo/issues/42/labels/missing" {
+							w.WriteHeader(http.StatusNotFound)
+							_, _ = w.Write([]byte(`{"message": "Label does not exist"}`))
+							return
+						}
+						assert.Equal(t, "/repos/owner/repo/issues/42/labels/triage", r.URL.Path)
+						w.WriteHeader(http.StatusOK)
+						_, _ = w.Write([]byte(`[{"name": "bug", "color": "d73a4a"}]`))
+					}),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposIssuesLabelsByOwnerByRepoByIssueNumber,
+					[]*github.Label{{Name: github.Ptr("bug"), Color: github.Ptr("d73a4a")}},
+				),
+			),
+			labels: []any{"triage", "missing"},
+		},
+		{
+			name: "removes a label with a slash",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					deleteReposIssuesLabelsByOwnerByRepoByIssueNumberBySlashedName,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/issues/42/labels/kind%2Fbug", r.URL.EscapedPath())
+						w.WriteHeader(http.StatusOK)
+						_, _ = w.Write([]byte(`[{"name": "bug", "color": "d73a4a"}]`))
+					}),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposIssuesLabelsByOwnerByRepoByIssueNumber,
+					[]*github.Label{{Name: github.Ptr("bug"), Color: github.Ptr("d73a4a")}},
+				),
+			),
+			labels: []any{"kind/bug"},
+		},
+		{
+			name: "label still on the issue after a 404",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					deleteReposIssuesLabelsByOwnerByRepoByIssueNumberBySlashedName,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposIssuesLabelsByOwnerByRepoByIssueNumber,
+					[]*github.Label{
+						{Name: github.Ptr("bug"), Color: github.Ptr("d73a4a")},
+						{Name: github.Ptr("kind/bug"), Color: github.Ptr("ededed")},
+					},
+				),
+			),
+			labels:         []any{"kind/bug"},
+			expectError:    true,
+			expectedErrMsg: `failed to remove label "kind/bug"`,
+		},
+		{
+			name: "removal fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposIssuesLabelsByOwnerByRepoByIssueNumberByName,
+					mockResponse(t, http.StatusForbidden, `{"message": "Must have push access"}`),
+				),
+			),
+			labels:         []any{"triage", "missing"},
+			expectError:    true,
+			expectedErrMsg: `failed to remove label "triage"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RemoveLabelsFromIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"labels":       tc.labels,
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var returned []MinimalLabel
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, []MinimalLabel{{Name: "bug", Color: "d73a4a"}}, returned)
+		})
+	}
+}
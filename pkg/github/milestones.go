@@ -0,0 +1,316 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-http/pkg/errors"
+	"github.com/github/github-mcp-http/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ListMilestones creates a tool to list the milestones of a repository.
+func ListMilestones(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_milestones",
+			mcp.WithDescription(t("TOOL_LIST_MILESTONES_DESCRIPTION", "List the milestones of a repository, with their due dates and progress (open and closed issue counts).")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_MILESTONES_USER_TITLE", "List milestones"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("state",
+				mcp.Description("Filter by state (default: open)"),
+				mcp.Enum("open", "closed", "all"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort by due date or completeness (default: due_on)"),
+				mcp.Enum("due_on", "completeness"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("Sort direction (default: asc)"),
+				mcp.Enum("asc", "desc"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := OptionalParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sort, err := OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			direction, err := OptionalParam[string](request, "direction")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			milestones, resp, err := client.Issues.ListMilestones(ctx, owner, repo, &github.MilestoneListOptions{
+				State:     state,
+				Sort:      sort,
+				Direction: direction,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list milestones", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			minimalMilestones := make([]MinimalMilestone, len(milestones))
+			for i, milestone := range milestones {
+				minimalMilestones[i] = convertToMinimalMilestone(milestone)
+			}
+			return MarshalledTextResult(minimalMilestones), nil
+		}
+}
+
+// milestoneFields reads the optional title, description, due_on and state parameters of a milestone.
+// It reports whether any of them were provided.
+func milestoneFields(request mcp.CallToolRequest, milestone *github.Milestone) (bool, error) {
+	provided := false
+	if title, ok, err := OptionalParamOK[string](request, "title"); err != nil {
+		return false, err
+	} else if ok && title != "" {
+		milestone.Title = github.Ptr(title)
+		provided = true
+	}
+	if description, ok, err := OptionalParamOK[string](request, "description"); err != nil {
+		return false, err
+	} else if ok {
+		milestone.Description = github.Ptr(description)
+		provided = true
+	}
+	if dueOn, ok, err := OptionalParamOK[string](request, "due_on"); err != nil {
+		return false, err
+	} else if ok && dueOn != "" {
+		due, err := parseISOTimestamp(dueOn)
+		if err != nil {
+			return false, fmt.Errorf("invalid due_on: %w", err)
+		}
+		milestone.DueOn = &github.Timestamp{Time: due}
+		provided = true
+	}
+	if state, ok, err := OptionalParamOK[string](request, "state"); err != nil {
+		return false, err
+	} else if ok && state != "" {
+		milestone.State = github.Ptr(state)
+		provided = true
+	}
+	return provided, nil
+}
+
+// withMilestoneFields adds the optional description, due_on and state parameters of a milestone.
+func withMilestoneFields() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("description",
+			mcp.Description("Description of the milestone"),
+		)(tool)
+		mcp.WithString("due_on",
+			mcp.Description("Due date of the milestone in ISO 8601 format (YYYY-MM-DD or YYYY-MM-DDThh:mm:ssZ)"),
+		)(tool)
+		mcp.WithString("state",
+			mcp.Description("State of the milestone"),
+			mcp.Enum("open", "closed"),
+		)(tool)
+	}
+}
+
+// CreateMilestone creates a tool to create a milestone in a repository.
+func CreateMilestone(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("create_milestone",
+			mcp.WithDescription(t("TOOL_CREATE_MILESTONE_DESCRIPTION", "Create a milestone in a repository.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_MILESTONE_USER_TITLE", "Create milestone"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Title of the milestone"),
+			),
+			withMilestoneFields(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if _, err := RequiredParam[string](request, "title"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			milestone := &github.Milestone{}
+			if _, err := milestoneFields(request, milestone); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			created, resp, err := client.Issues.CreateMilestone(ctx, owner, repo, milestone)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create milestone", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(convertToMinimalMilestone(created)), nil
+		}
+}
+
+// UpdateMilestone creates a tool to update a milestone of a repository.
+func UpdateMilestone(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("update_milestone",
+			mcp.WithDescription(t("TOOL_UPDATE_MILESTONE_DESCRIPTION", "Update the title, description, due date or state of a milestone.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_MILESTONE_USER_TITLE", "Update milestone"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("milestone_number",
+				mcp.Required(),
+				mcp.Description("The number of the milestone"),
+			),
+			mcp.WithString("title",
+				mcp.Description("New title of the milestone"),
+			),
+			withMilestoneFields(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			number, err := RequiredInt(request, "milestone_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			milestone := &github.Milestone{}
+			provided, err := milestoneFields(request, milestone)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !provided {
+				return mcp.NewToolResultError("No update parameters provided."), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			updated, resp, err := client.Issues.EditMilestone(ctx, owner, repo, number, milestone)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to update milestone", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(convertToMinimalMilestone(updated)), nil
+		}
+}
+
+// CloseMilestone creates a tool to close a milestone of a repository.
+func CloseMilestone(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("close_milestone",
+			mcp.WithDescription(t("TOOL_CLOSE_MILESTONE_DESCRIPTION", "Close a milestone. Its issues and pull requests keep the milestone. Returns the final progress of the milestone.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CLOSE_MILESTONE_USER_TITLE", "Close milestone"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("milestone_number",
+				mcp.Required(),
+				mcp.Description("The number of the milestone"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			number, err := RequiredInt(request, "milestone_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			closed, resp, err := client.Issues.EditMilestone(ctx, owner, repo, number, &github.Milestone{
+				State: github.Ptr("closed"),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to close milestone", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(convertToMinimalMilestone(closed)), nil
+		}
+}
@@ -0,0 +1,315 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-http/internal/toolsnaps"
+	"github.com/github/github-mcp-http/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListMilestones(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListMilestones(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_milestones", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	dueOn := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	milestones := []*github.Milestone{
+		{
+			Number:       github.Ptr(1),
+			Title:        github.Ptr("v1.0"),
+			State:        github.Ptr("open"),
+			DueOn:        &github.Timestamp{Time: dueOn},
+			OpenIssues:   github.Ptr(1),
+			ClosedIssues: github.Ptr(2),
+			HTMLURL:      github.Ptr("https://github.com/owner/repo/milestone/1"),
+		},
+		{
+			Number: github.Ptr(2),
+			Title:  github.Ptr("Backlog"),
+			State:  github.Ptr("open"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful list",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposMilestonesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"state":     "all",
+						"sort":      "completeness",
+						"direction": "desc",
+						"page":      "1",
+						"per_page":  "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, milestones),
+					),
+				),
+			),
+		},
+		{
+			name: "list fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposMilestonesByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to list milestones",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListMilestones(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"state":     "all",
+				"sort":      "completeness",
+				"direction": "desc",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var returned []MinimalMilestone
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			require.Len(t, returned, 2)
+			assert.Equal(t, "v1.0", returned[0].Title)
+			assert.Equal(t, "2025-03-31T00:00:00Z", returned[0].DueOn)
+			assert.Equal(t, 1, returned[0].OpenIssues)
+			assert.Equal(t, 2, returned[0].ClosedIssues)
+			assert.Equal(t, 66, returned[0].PercentComplete)
+			assert.Equal(t, 0, returned[1].PercentComplete)
+		})
+	}
+}
+
+func Test_CreateMilestone(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateMilestone(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_milestone", tool.Name)
+	assert.Contains(t, tool.InputSchema.Properties, "due_on")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "title"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful create",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposMilestonesByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"title":       "v2.0",
+						"description": "Next major release",
+						"due_on":      "2025-06-30T00:00:00Z",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Milestone{
+							Number: github.Ptr(3),
+							Title:  github.Ptr("v2.0"),
+							State:  github.Ptr("open"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"title":       "v2.0",
+				"description": "Next major release",
+				"due_on":      "2025-06-30",
+			},
+		},
+		{
+			name:         "invalid due date",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"title":  "v2.0",
+				"due_on": "next week",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid due_on",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateMilestone(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var returned MinimalMilestone
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, 3, returned.Number)
+			assert.Equal(t, "v2.0", returned.Title)
+		})
+	}
+}
+
+func Test_UpdateMilestone(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateMilestone(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_milestone", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "milestone_number"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful update",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposMilestonesByOwnerByRepoByMilestoneNumber,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/milestones/3",
+						requestBody: map[string]any{"title": "v2.1"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Milestone{
+							Number: github.Ptr(3),
+							Title:  github.Ptr("v2.1"),
+							State:  github.Ptr("open"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":            "owner",
+				"repo":             "repo",
+				"milestone_number": float64(3),
+				"title":            "v2.1",
+			},
+		},
+		{
+			name:         "no update parameters",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":            "owner",
+				"repo":             "repo",
+				"milestone_number": float64(3),
+			},
+			expectError:    true,
+			expectedErrMsg: "No update parameters provided.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateMilestone(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var returned MinimalMilestone
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, "v2.1", returned.Title)
+		})
+	}
+}
+
+func Test_CloseMilestone(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CloseMilestone(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "close_milestone", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "milestone_number"})
+
+	closedAt := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PatchReposMilestonesByOwnerByRepoByMilestoneNumber,
+			expectRequestBody(t, map[string]any{"state": "closed"}).andThen(
+				mockResponse(t, http.StatusOK, &github.Milestone{
+					Number:       github.Ptr(1),
+					Title:        github.Ptr("v1.0"),
+					State:        github.Ptr("closed"),
+					OpenIssues:   github.Ptr(0),
+					ClosedIssues: github.Ptr(4),
+					ClosedAt:     &github.Timestamp{Time: closedAt},
+				}),
+			),
+		),
+	)
+
+	client := github.NewClient(mockedClient)
+	_, handler := CloseMilestone(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":            "owner",
+		"repo":             "repo",
+		"milestone_number": float64(1),
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned MinimalMilestone
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, "closed", returned.State)
+	assert.Equal(t, 100, returned.PercentComplete)
+	assert.Equal(t, "2025-04-01T12:00:00Z", returned.ClosedAt)
+}
@@ -97,6 +97,56 @@ type MinimalRelease struct {
 	Prerelease  bool         `json:"prerelease"`
 	Draft       bool         `json:"draft"`
 	Author      *MinimalUser `json:"author,omitempty"`
+
+	Assets []MinimalReleaseAsset `json:"assets,omitempty"`
+}
+
+// MinimalReleaseAsset is the trimmed output type for release asset objects.
+type MinimalReleaseAsset struct {
+	ID                 int64  `json:"id"`
+	Name               string `json:"name"`
+	Label              string `json:"label,omitempty"`
+	ContentType        string `json:"content_type"`
+	Size               int    `json:"size"`
+	DownloadCount      int    `json:"download_count"`
+	BrowserDownloadURL string `json:"browser_download_url"`
+}
+
+// MinimalWebhook is the trimmed output type for repository and organization webhooks.
+// The secret is never included, only whether one is set.
+type MinimalWebhook struct {
+	ID          int64    `json:"id"`
+	Name        string   `json:"name"`
+	URL         string   `json:"url"`
+	ContentType string   `json:"content_type,omitempty"`
+	InsecureSSL bool     `json:"insecure_ssl"`
+	HasSecret   bool     `json:"has_secret"`
+	Events      []string `json:"events"`
+	Active      bool     `json:"active"`
+	CreatedAt   string   `json:"created_at,omitempty"`
+	UpdatedAt   string   `json:"updated_at,omitempty"`
+	// LastResponse is the result of the most recent delivery, if any.
+	LastResponse *MinimalWebhookResponse `json:"last_response,omitempty"`
+}
+
+// MinimalWebhookResponse is the trimmed output type for the last response of a webhook.
+type MinimalWebhookResponse struct {
+	Code    int    `json:"code,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// MinimalHookDelivery is the trimmed output type for webhook deliveries.
+type MinimalHookDelivery struct {
+	ID          int64   `json:"id"`
+	GUID        string  `json:"guid"`
+	DeliveredAt string  `json:"delivered_at,omitempty"`
+	Redelivery  bool    `json:"redelivery"`
+	Duration    float64 `json:"duration"`
+	Status      string  `json:"status"`
+	StatusCode  int     `json:"status_code"`
+	Event       string  `json:"event"`
+	Action      string  `json:"action,omitempty"`
 }
 
 // MinimalBranch is the trimmed output type for branch objects.
@@ -114,6 +164,119 @@ type MinimalResponse struct {
 	URL string `json:"url"`
 }
 
+// MinimalPullRequestUpdateResponse represents the result of updating a pull request, including its resulting state.
+type MinimalPullRequestUpdateResponse struct {
+	ID    string `json:"id"`
+	URL   string `json:"url"`
+	State string `json:"state"`
+	Draft bool   `json:"draft"`
+}
+
+// MinimalBranchProtection is the trimmed output type for the classic branch protection of a branch.
+// Users, teams and apps are listed as "user:login", "team:slug" and "app:slug".
+type MinimalBranchProtection struct {
+	Branch                         string                       `json:"branch"`
+	Protected                      bool                         `json:"protected"`
+	RequiredStatusChecks           *MinimalRequiredStatusChecks `json:"required_status_checks,omitempty"`
+	RequiredPullRequestReviews     *MinimalRequiredReviews      `json:"required_pull_request_reviews,omitempty"`
+	EnforceAdmins                  bool                         `json:"enforce_admins"`
+	RequiredLinearHistory          bool                         `json:"required_linear_history"`
+	RequiredSignatures             bool                         `json:"required_signatures"`
+	RequiredConversationResolution bool                         `json:"required_conversation_resolution"`
+	AllowForcePushes               bool                         `json:"allow_force_pushes"`
+	AllowDeletions                 bool                         `json:"allow_deletions"`
+	LockBranch                     bool                         `json:"lock_branch"`
+	PushRestrictedTo               []string                     `json:"push_restricted_to,omitempty"`
+}
+
+// MinimalRequiredStatusChecks lists the status checks required by branch protection.
+type MinimalRequiredStatusChecks struct {
+	Strict bool     `json:"strict"`
+	Checks []string `json:"checks"`
+}
+
+// MinimalRequiredReviews is the pull request review requirement of branch protection.
+type MinimalRequiredReviews struct {
+	RequiredApprovingReviewCount int      `json:"required_approving_review_count"`
+	DismissStaleReviews          bool     `json:"dismiss_stale_reviews"`
+	RequireCodeOwnerReviews      bool     `json:"require_code_owner_reviews"`
+	RequireLastPushApproval      bool     `json:"require_last_push_approval"`
+	BypassAllowances             []string `json:"bypass_allowances,omitempty"`
+}
+
+// MinimalRuleset is the trimmed output type for repository rulesets.
+type MinimalRuleset struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Target      string `json:"target,omitempty"`
+	Source      string `json:"source"`
+	SourceType  string `json:"source_type,omitempty"`
+	Enforcement string `json:"enforcement"`
+}
+
+// MinimalCollaborator is the trimmed output type for repository collaborators.
+type MinimalCollaborator struct {
+	Login      string `json:"login"`
+	ID         int64  `json:"id,omitempty"`
+	Permission string `json:"permission"`
+	RoleName   string `json:"role_name,omitempty"`
+	ProfileURL string `json:"profile_url,omitempty"`
+}
+
+// MinimalOrgMember is the trimmed output type for organization members.
+type MinimalOrgMember struct {
+	Login      string `json:"login"`
+	ID         int64  `json:"id,omitempty"`
+	Role       string `json:"role,omitempty"`
+	ProfileURL string `json:"profile_url,omitempty"`
+}
+
+// MinimalOrgInvitation is the trimmed output type for pending organization invitations.
+type MinimalOrgInvitation struct {
+	ID           int64  `json:"id"`
+	Login        string `json:"login,omitempty"`
+	Email        string `json:"email,omitempty"`
+	Role         string `json:"role"`
+	Inviter      string `json:"inviter,omitempty"`
+	TeamCount    int    `json:"team_count,omitempty"`
+	CreatedAt    string `json:"created_at,omitempty"`
+	FailedReason string `json:"failed_reason,omitempty"`
+}
+
+// MinimalRepositoryTeam is the trimmed output type for teams with access to a repository.
+type MinimalRepositoryTeam struct {
+	Slug       string `json:"slug"`
+	Name       string `json:"name"`
+	Permission string `json:"permission"`
+	Privacy    string `json:"privacy,omitempty"`
+	HTMLURL    string `json:"html_url,omitempty"`
+}
+
+// MinimalDiscussion is the trimmed output type for discussions found by search_discussions.
+type MinimalDiscussion struct {
+	Number     int    `json:"number"`
+	Title      string `json:"title"`
+	Repository string `json:"repository"`
+	Category   string `json:"category,omitempty"`
+	Author     string `json:"author,omitempty"`
+	Closed     bool   `json:"closed"`
+	Answered   bool   `json:"answered"`
+	Comments   int    `json:"comments"`
+	URL        string `json:"url"`
+	CreatedAt  string `json:"created_at"`
+	UpdatedAt  string `json:"updated_at"`
+}
+
+// MinimalDiscussionResponse represents the result of creating or changing a discussion, including its resulting state.
+type MinimalDiscussionResponse struct {
+	ID       string `json:"id"`
+	Number   int    `json:"number"`
+	URL      string `json:"url"`
+	Closed   bool   `json:"closed"`
+	Locked   bool   `json:"locked"`
+	Answered bool   `json:"answered"`
+}
+
 type MinimalProject struct {
 	ID               *int64            `json:"id,omitempty"`
 	NodeID           *string           `json:"node_id,omitempty"`
@@ -269,14 +432,7 @@ func convertToMinimalCommit(commit *github.RepositoryCommit, includeDiffs bool)
 		if len(commit.Files) > 0 {
 			minimalCommit.Files = make([]MinimalCommitFile, 0, len(commit.Files))
 			for _, file := range commit.Files {
-				minimalFile := MinimalCommitFile{
-					Filename:  file.GetFilename(),
-					Status:    file.GetStatus(),
-					Additions: file.GetAdditions(),
-					Deletions: file.GetDeletions(),
-					Changes:   file.GetChanges(),
-				}
-				minimalCommit.Files = append(minimalCommit.Files, minimalFile)
+				minimalCommit.Files = append(minimalCommit.Files, convertToMinimalCommitFile(file))
 			}
 		}
 	}
@@ -284,6 +440,16 @@ func convertToMinimalCommit(commit *github.RepositoryCommit, includeDiffs bool)
 	return minimalCommit
 }
 
+func convertToMinimalCommitFile(file *github.CommitFile) MinimalCommitFile {
+	return MinimalCommitFile{
+		Filename:  file.GetFilename(),
+		Status:    file.GetStatus(),
+		Additions: file.GetAdditions(),
+		Deletions: file.GetDeletions(),
+		Changes:   file.GetChanges(),
+	}
+}
+
 // convertToMinimalBranch converts a GitHub API Branch to MinimalBranch
 func convertToMinimalBranch(branch *github.Branch) MinimalBranch {
 	return MinimalBranch{
@@ -292,3 +458,480 @@ func convertToMinimalBranch(branch *github.Branch) MinimalBranch {
 		Protected: branch.GetProtected(),
 	}
 }
+
+// MinimalCheckRunOutput is the trimmed output type for the output of a check run.
+type MinimalCheckRunOutput struct {
+	Title            string `json:"title,omitempty"`
+	Summary          string `json:"summary,omitempty"`
+	Text             string `json:"text,omitempty"`
+	AnnotationsCount int    `json:"annotations_count"`
+}
+
+// MinimalCheckRun is the trimmed output type for check run objects.
+type MinimalCheckRun struct {
+	ID           int64                  `json:"id"`
+	Name         string                 `json:"name"`
+	HeadSHA      string                 `json:"head_sha"`
+	Status       string                 `json:"status"`
+	Conclusion   string                 `json:"conclusion,omitempty"`
+	App          string                 `json:"app,omitempty"`
+	CheckSuiteID int64                  `json:"check_suite_id,omitempty"`
+	StartedAt    string                 `json:"started_at,omitempty"`
+	CompletedAt  string                 `json:"completed_at,omitempty"`
+	HTMLURL      string                 `json:"html_url,omitempty"`
+	DetailsURL   string                 `json:"details_url,omitempty"`
+	Output       *MinimalCheckRunOutput `json:"output,omitempty"`
+}
+
+// MinimalCheckRunsResult is the trimmed output type for check run listings.
+type MinimalCheckRunsResult struct {
+	TotalCount int               `json:"total_count"`
+	Ref        string            `json:"ref"`
+	CheckRuns  []MinimalCheckRun `json:"check_runs"`
+}
+
+// MinimalCheckSuite is the trimmed output type for check suite objects.
+type MinimalCheckSuite struct {
+	ID         int64  `json:"id"`
+	App        string `json:"app,omitempty"`
+	HeadBranch string `json:"head_branch,omitempty"`
+	HeadSHA    string `json:"head_sha"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion,omitempty"`
+	CreatedAt  string `json:"created_at,omitempty"`
+	UpdatedAt  string `json:"updated_at,omitempty"`
+}
+
+// MinimalCheckSuitesResult is the trimmed output type for check suite listings.
+type MinimalCheckSuitesResult struct {
+	TotalCount  int                 `json:"total_count"`
+	Ref         string              `json:"ref"`
+	CheckSuites []MinimalCheckSuite `json:"check_suites"`
+}
+
+// PullRequestCheckSummary is the rolled-up readiness of a pull request.
+type PullRequestCheckSummary struct {
+	HeadSHA        string   `json:"head_sha"`
+	State          string   `json:"state"`
+	Ready          bool     `json:"ready"`
+	Blockers       []string `json:"blockers"`
+	Draft          bool     `json:"draft"`
+	Mergeable      *bool    `json:"mergeable,omitempty"`
+	MergeableState string   `json:"mergeable_state,omitempty"`
+	TotalCount     int      `json:"total_count"`
+	PassingCount   int      `json:"passing_count"`
+	FailingChecks  []string `json:"failing_checks"`
+	PendingChecks  []string `json:"pending_checks"`
+	// Truncated is set when there were more checks than could be read.
+	Truncated bool `json:"truncated"`
+}
+
+func convertToMinimalCheckRun(checkRun *github.CheckRun, includeOutput bool) MinimalCheckRun {
+	minimal := MinimalCheckRun{
+		ID:           checkRun.GetID(),
+		Name:         checkRun.GetName(),
+		HeadSHA:      checkRun.GetHeadSHA(),
+		Status:       checkRun.GetStatus(),
+		Conclusion:   checkRun.GetConclusion(),
+		App:          checkRun.GetApp().GetSlug(),
+		CheckSuiteID: checkRun.GetCheckSuite().GetID(),
+		HTMLURL:      checkRun.GetHTMLURL(),
+		DetailsURL:   checkRun.GetDetailsURL(),
+	}
+	if checkRun.StartedAt != nil {
+		minimal.StartedAt = checkRun.StartedAt.Format("2006-01-02T15:04:05Z")
+	}
+	if checkRun.CompletedAt != nil {
+		minimal.CompletedAt = checkRun.CompletedAt.Format("2006-01-02T15:04:05Z")
+	}
+	if output := checkRun.GetOutput(); output != nil {
+		minimal.Output = &MinimalCheckRunOutput{
+			Title:            output.GetTitle(),
+			AnnotationsCount: output.GetAnnotationsCount(),
+		}
+		if includeOutput {
+			minimal.Output.Summary = output.GetSummary()
+			minimal.Output.Text = output.GetText()
+		}
+	}
+	return minimal
+}
+
+func convertToMinimalCheckSuite(checkSuite *github.CheckSuite) MinimalCheckSuite {
+	minimal := MinimalCheckSuite{
+		ID:         checkSuite.GetID(),
+		App:        checkSuite.GetApp().GetSlug(),
+		HeadBranch: checkSuite.GetHeadBranch(),
+		HeadSHA:    checkSuite.GetHeadSHA(),
+		Status:     checkSuite.GetStatus(),
+		Conclusion: checkSuite.GetConclusion(),
+	}
+	if checkSuite.CreatedAt != nil {
+		minimal.CreatedAt = checkSuite.CreatedAt.Format("2006-01-02T15:04:05Z")
+	}
+	if checkSuite.UpdatedAt != nil {
+		minimal.UpdatedAt = checkSuite.UpdatedAt.Format("2006-01-02T15:04:05Z")
+	}
+	return minimal
+}
+
+// MinimalTimelineEvent is the trimmed output type for issue and pull request timeline events.
+type MinimalTimelineEvent struct {
+	Event             string               `json:"event"`
+	ID                int64                `json:"id,omitempty"`
+	Actor             string               `json:"actor,omitempty"`
+	CreatedAt         string               `json:"created_at,omitempty"`
+	CommitID          string               `json:"commit_id,omitempty"`
+	SHA               string               `json:"sha,omitempty"`
+	Message           string               `json:"message,omitempty"`
+	Label             string               `json:"label,omitempty"`
+	Assignee          string               `json:"assignee,omitempty"`
+	Milestone         string               `json:"milestone,omitempty"`
+	RequestedReviewer string               `json:"requested_reviewer,omitempty"`
+	RequestedTeam     string               `json:"requested_team,omitempty"`
+	State             string               `json:"state,omitempty"`
+	Body              string               `json:"body,omitempty"`
+	Rename            *MinimalRename       `json:"rename,omitempty"`
+	Source            *MinimalEventSource  `json:"source,omitempty"`
+	Author            *MinimalCommitAuthor `json:"author,omitempty"`
+}
+
+// MinimalRename is the title change of a renamed timeline event.
+type MinimalRename struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// MinimalEventSource is the issue or pull request that cross-referenced the timeline's issue.
+type MinimalEventSource struct {
+	Number      int    `json:"number"`
+	Title       string `json:"title,omitempty"`
+	State       string `json:"state,omitempty"`
+	HTMLURL     string `json:"html_url,omitempty"`
+	Repository  string `json:"repository,omitempty"`
+	PullRequest bool   `json:"pull_request"`
+}
+
+// PullRequestMergeability is the merge-readiness report of a pull request.
+type PullRequestMergeability struct {
+	State                  string          `json:"state"`
+	CanMerge               bool            `json:"can_merge"`
+	Blockers               []string        `json:"blockers"`
+	Notes                  []string        `json:"notes,omitempty"`
+	Draft                  bool            `json:"draft"`
+	Mergeable              *bool           `json:"mergeable"`
+	MergeableState         string          `json:"mergeable_state,omitempty"`
+	BaseBranch             string          `json:"base_branch"`
+	HeadSHA                string          `json:"head_sha"`
+	RequiredApprovals      int             `json:"required_approvals"`
+	Approvals              []string        `json:"approvals"`
+	ChangesRequestedBy     []string        `json:"changes_requested_by"`
+	CodeOwnerReview        bool            `json:"code_owner_review_required"`
+	ConversationResolution bool            `json:"conversation_resolution_required"`
+	RequiredChecks         []RequiredCheck `json:"required_checks"`
+	// ChecksTruncated is set when there were more checks on the head commit than could be read.
+	ChecksTruncated bool `json:"checks_truncated"`
+}
+
+// RequiredCheck is the state of a status check required by branch protection or a ruleset.
+type RequiredCheck struct {
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
+func convertToMinimalTimelineEvent(event *github.Timeline) MinimalTimelineEvent {
+	minimal := MinimalTimelineEvent{
+		Event:             event.GetEvent(),
+		ID:                event.GetID(),
+		Actor:             event.GetActor().GetLogin(),
+		CommitID:          event.GetCommitID(),
+		SHA:               event.GetSHA(),
+		Message:           event.GetMessage(),
+		Label:             event.GetLabel().GetName(),
+		Assignee:          event.GetAssignee().GetLogin(),
+		Milestone:         event.GetMilestone().GetTitle(),
+		RequestedReviewer: event.GetReviewer().GetLogin(),
+		RequestedTeam:     event.GetRequestedTeam().GetSlug(),
+		State:             event.GetState(),
+		Body:              event.GetBody(),
+	}
+	if minimal.Actor == "" {
+		// Reviews and comments report their author as user rather than actor.
+		minimal.Actor = event.GetUser().GetLogin()
+	}
+	switch {
+	case event.CreatedAt != nil:
+		minimal.CreatedAt = event.CreatedAt.Format("2006-01-02T15:04:05Z")
+	case event.SubmittedAt != nil:
+		minimal.CreatedAt = event.SubmittedAt.Format("2006-01-02T15:04:05Z")
+	}
+	if event.Rename != nil {
+		minimal.Rename = &MinimalRename{
+			From: event.Rename.GetFrom(),
+			To:   event.Rename.GetTo(),
+		}
+	}
+	if issue := event.GetSource().GetIssue(); issue != nil {
+		minimal.Source = &MinimalEventSource{
+			Number:      issue.GetNumber(),
+			Title:       issue.GetTitle(),
+			State:       issue.GetState(),
+			HTMLURL:     issue.GetHTMLURL(),
+			Repository:  issue.GetRepository().GetFullName(),
+			PullRequest: issue.IsPullRequest(),
+		}
+	}
+	if event.Author != nil {
+		minimal.Author = &MinimalCommitAuthor{
+			Name:  event.Author.GetName(),
+			Email: event.Author.GetEmail(),
+		}
+		if event.Author.Date != nil {
+			minimal.Author.Date = event.Author.Date.Format("2006-01-02T15:04:05Z")
+		}
+	}
+	return minimal
+}
+
+// MinimalLabel is the trimmed output type for repository labels.
+type MinimalLabel struct {
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description,omitempty"`
+	Default     bool   `json:"default,omitempty"`
+}
+
+// MinimalMilestone is the trimmed output type for milestones, including their progress.
+type MinimalMilestone struct {
+	Number       int    `json:"number"`
+	Title        string `json:"title"`
+	Description  string `json:"description,omitempty"`
+	State        string `json:"state"`
+	DueOn        string `json:"due_on,omitempty"`
+	OpenIssues   int    `json:"open_issues"`
+	ClosedIssues int    `json:"closed_issues"`
+	// PercentComplete is the share of closed issues and pull requests, rounded down.
+	PercentComplete int    `json:"percent_complete"`
+	HTMLURL         string `json:"html_url"`
+	ClosedAt        string `json:"closed_at,omitempty"`
+}
+
+func convertToMinimalLabel(label *github.Label) MinimalLabel {
+	return MinimalLabel{
+		Name:        label.GetName(),
+		Color:       label.GetColor(),
+		Description: label.GetDescription(),
+		Default:     label.GetDefault(),
+	}
+}
+
+func convertToMinimalLabels(labels []*github.Label) []MinimalLabel {
+	minimalLabels := make([]MinimalLabel, len(labels))
+	for i, label := range labels {
+		minimalLabels[i] = convertToMinimalLabel(label)
+	}
+	return minimalLabels
+}
+
+func convertToMinimalMilestone(milestone *github.Milestone) MinimalMilestone {
+	minimal := MinimalMilestone{
+		Number:       milestone.GetNumber(),
+		Title:        milestone.GetTitle(),
+		Description:  milestone.GetDescription(),
+		State:        milestone.GetState(),
+		OpenIssues:   milestone.GetOpenIssues(),
+		ClosedIssues: milestone.GetClosedIssues(),
+		HTMLURL:      milestone.GetHTMLURL(),
+	}
+	if total := minimal.OpenIssues + minimal.ClosedIssues; total > 0 {
+		minimal.PercentComplete = minimal.ClosedIssues * 100 / total
+	}
+	if milestone.DueOn != nil {
+		minimal.DueOn = milestone.DueOn.Format("2006-01-02T15:04:05Z")
+	}
+	if milestone.ClosedAt != nil {
+		minimal.ClosedAt = milestone.ClosedAt.Format("2006-01-02T15:04:05Z")
+	}
+	return minimal
+}
+
+func convertToMinimalRuleset(ruleset *github.RepositoryRuleset) MinimalRuleset {
+	minimalRuleset := MinimalRuleset{
+		ID:          ruleset.GetID(),
+		Name:        ruleset.Name,
+		Source:      ruleset.Source,
+		Enforcement: string(ruleset.Enforcement),
+	}
+	if ruleset.Target != nil {
+		minimalRuleset.Target = string(*ruleset.Target)
+	}
+	if ruleset.SourceType != nil {
+		minimalRuleset.SourceType = string(*ruleset.SourceType)
+	}
+	return minimalRuleset
+}
+
+// permissionLevels lists repository permissions from the highest to the lowest.
+var permissionLevels = []string{"admin", "maintain", "push", "triage", "pull"}
+
+// highestPermission returns the highest of the permissions flagged in a REST permissions map.
+func highestPermission(permissions map[string]bool) string {
+	for _, level := range permissionLevels {
+		if permissions[level] {
+			return level
+		}
+	}
+	return ""
+}
+
+func convertToMinimalCollaborator(user *github.User) MinimalCollaborator {
+	return MinimalCollaborator{
+		Login:      user.GetLogin(),
+		ID:         user.GetID(),
+		Permission: highestPermission(user.GetPermissions()),
+		RoleName:   user.GetRoleName(),
+		ProfileURL: user.GetHTMLURL(),
+	}
+}
+
+func convertToMinimalRepositoryTeam(team *github.Team) MinimalRepositoryTeam {
+	minimalTeam := MinimalRepositoryTeam{
+		Slug:       team.GetSlug(),
+		Name:       team.GetName(),
+		Permission: highestPermission(team.GetPermissions()),
+		Privacy:    team.GetPrivacy(),
+		HTMLURL:    team.GetHTMLURL(),
+	}
+	if minimalTeam.Permission == "" {
+		minimalTeam.Permission = team.GetPermission()
+	}
+	return minimalTeam
+}
+
+func convertToMinimalRepository(repo *github.Repository) MinimalRepository {
+	minimalRepo := MinimalRepository{
+		ID:            repo.GetID(),
+		Name:          repo.GetName(),
+		FullName:      repo.GetFullName(),
+		Description:   repo.GetDescription(),
+		HTMLURL:       repo.GetHTMLURL(),
+		Language:      repo.GetLanguage(),
+		Stars:         repo.GetStargazersCount(),
+		Forks:         repo.GetForksCount(),
+		OpenIssues:    repo.GetOpenIssuesCount(),
+		Private:       repo.GetPrivate(),
+		Fork:          repo.GetFork(),
+		Archived:      repo.GetArchived(),
+		DefaultBranch: repo.GetDefaultBranch(),
+	}
+
+	if repo.UpdatedAt != nil {
+		minimalRepo.UpdatedAt = repo.UpdatedAt.Format("2006-01-02T15:04:05Z")
+	}
+	if repo.CreatedAt != nil {
+		minimalRepo.CreatedAt = repo.CreatedAt.Format("2006-01-02T15:04:05Z")
+	}
+	if repo.Topics != nil {
+		minimalRepo.Topics = repo.Topics
+	}
+	return minimalRepo
+}
+
+func convertToMinimalOrgInvitation(invitation *github.Invitation) MinimalOrgInvitation {
+	minimalInvitation := MinimalOrgInvitation{
+		ID:           invitation.GetID(),
+		Login:        invitation.GetLogin(),
+		Email:        invitation.GetEmail(),
+		Role:         invitation.GetRole(),
+		Inviter:      invitation.GetInviter().GetLogin(),
+		TeamCount:    invitation.GetTeamCount(),
+		FailedReason: invitation.GetFailedReason(),
+	}
+	if invitation.CreatedAt != nil {
+		minimalInvitation.CreatedAt = invitation.CreatedAt.Format("2006-01-02T15:04:05Z")
+	}
+	return minimalInvitation
+}
+
+func convertToMinimalRelease(release *github.RepositoryRelease) MinimalRelease {
+	minimalRelease := MinimalRelease{
+		ID:         release.GetID(),
+		TagName:    release.GetTagName(),
+		Name:       release.GetName(),
+		Body:       release.GetBody(),
+		HTMLURL:    release.GetHTMLURL(),
+		Prerelease: release.GetPrerelease(),
+		Draft:      release.GetDraft(),
+		Author:     convertToMinimalUser(release.Author),
+	}
+	if release.PublishedAt != nil {
+		minimalRelease.PublishedAt = release.PublishedAt.Format("2006-01-02T15:04:05Z")
+	}
+	for _, asset := range release.Assets {
+		minimalRelease.Assets = append(minimalRelease.Assets, convertToMinimalReleaseAsset(asset))
+	}
+	return minimalRelease
+}
+
+func convertToMinimalReleaseAsset(asset *github.ReleaseAsset) MinimalReleaseAsset {
+	return MinimalReleaseAsset{
+		ID:                 asset.GetID(),
+		Name:               asset.GetName(),
+		Label:              asset.GetLabel(),
+		ContentType:        asset.GetContentType(),
+		Size:               asset.GetSize(),
+		DownloadCount:      asset.GetDownloadCount(),
+		BrowserDownloadURL: asset.GetBrowserDownloadURL(),
+	}
+}
+
+func convertToMinimalWebhook(hook *github.Hook) MinimalWebhook {
+	minimalHook := MinimalWebhook{
+		ID:          hook.GetID(),
+		Name:        hook.GetName(),
+		URL:         hook.GetConfig().GetURL(),
+		ContentType: hook.GetConfig().GetContentType(),
+		InsecureSSL: hook.GetConfig().GetInsecureSSL() == "1",
+		HasSecret:   hook.GetConfig().GetSecret() != "",
+		Events:      hook.Events,
+		Active:      hook.GetActive(),
+	}
+	if minimalHook.Events == nil {
+		minimalHook.Events = []string{}
+	}
+	if hook.CreatedAt != nil {
+		minimalHook.CreatedAt = hook.CreatedAt.Format("2006-01-02T15:04:05Z")
+	}
+	if hook.UpdatedAt != nil {
+		minimalHook.UpdatedAt = hook.UpdatedAt.Format("2006-01-02T15:04:05Z")
+	}
+	if len(hook.LastResponse) > 0 {
+		lastResponse := &MinimalWebhookResponse{}
+		if code, ok := hook.LastResponse["code"].(float64); ok {
+			lastResponse.Code = int(code)
+		}
+		lastResponse.Status, _ = hook.LastResponse["status"].(string)
+		lastResponse.Message, _ = hook.LastResponse["message"].(string)
+		minimalHook.LastResponse = lastResponse
+	}
+	return minimalHook
+}
+
+func convertToMinimalHookDelivery(delivery *github.HookDelivery) MinimalHookDelivery {
+	minimalDelivery := MinimalHookDelivery{
+		ID:         delivery.GetID(),
+		GUID:       delivery.GetGUID(),
+		Redelivery: delivery.GetRedelivery(),
+		Status:     delivery.GetStatus(),
+		StatusCode: delivery.GetStatusCode(),
+		Event:      delivery.GetEvent(),
+		Action:     delivery.GetAction(),
+	}
+	if delivery.Duration != nil {
+		minimalDelivery.Duration = *delivery.Duration
+	}
+	if delivery.DeliveredAt != nil {
+		minimalDelivery.DeliveredAt = delivery.DeliveredAt.Format("2006-01-02T15:04:05Z")
+	}
+	return minimalDelivery
+}
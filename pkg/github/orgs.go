@@ -0,0 +1,635 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-http/pkg/errors"
+	"github.com/github/github-mcp-http/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ListOrgMembers creates a tool to list the members of an organization with their roles.
+func ListOrgMembers(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_org_members",
+			mcp.WithDescription(t("TOOL_LIST_ORG_MEMBERS_DESCRIPTION", "List the members of an organization with their role: admin for organization owners, member for everyone else. Only public members are listed unless the authenticated user is a member of the organization.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ORG_MEMBERS_USER_TITLE", "List organization members"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithString("role",
+				mcp.Description("Only list members with this role (default: all)"),
+				mcp.Enum("all", "admin", "member"),
+			),
+			mcp.WithString("filter",
+				mcp.Description("Only list members without two-factor authentication with 2fa_disabled, requires being an organization owner (default: all)"),
+				mcp.Enum("all", "2fa_disabled"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			role, err := OptionalParam[string](request, "role")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if role == "" {
+				role = "all"
+			}
+			filter, err := OptionalParam[string](request, "filter")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			members, resp, err := client.Organizations.ListMembers(ctx, org, &github.ListMembersOptions{
+				Role:   role,
+				Filter: filter,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list organization members", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			// Members are listed without their role, so owners are looked up separately when both roles are listed.
+			// Every page of owners is read, so that no owner is reported as a plain member.
+			admins := map[string]bool{}
+			if role == "all" && len(members) > 0 {
+				opts := &github.ListMembersOptions{Role: "admin", ListOptions: github.ListOptions{PerPage: 100}}
+				for {
+					owners, resp, err := client.Organizations.ListMembers(ctx, org, opts)
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list organization owners", resp, err), nil
+					}
+					_ = resp.Body.Close()
+					for _, owner := range owners {
+						admins[owner.GetLogin()] = true
+					}
+					if resp.NextPage == 0 {
+						break
+					}
+					opts.Page = resp.NextPage
+				}
+			}
+
+			minimalMembers := make([]MinimalOrgMember, len(members))
+			for i, member := range members {
+				memberRole := role
+				if role == "all" {
+					memberRole = "member"
+					if admins[member.GetLogin()] {
+						memberRole = "admin"
+					}
+				}
+				minimalMembers[i] = MinimalOrgMember{
+					Login:      member.GetLogin(),
+					ID:         member.GetID(),
+					Role:       memberRole,
+					ProfileURL: member.GetHTMLURL(),
+				}
+			}
+			return MarshalledTextResult(minimalMembers), nil
+		}
+}
+
+// ListOrgInvitations creates a tool to list the pending invitations of an organization.
+func ListOrgInvitations(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_org_invitations",
+			mcp.WithDescription(t("TOOL_LIST_ORG_INVITATIONS_DESCRIPTION", "List the pending invitations to join an organization, with the role offered and who sent them. Requires being an organization owner.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ORG_INVITATIONS_USER_TITLE", "List organization invitations"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			invitations, resp, err := client.Organizations.ListPendingOrgInvitations(ctx, org, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list organization invitations", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			minimalInvitations := make([]MinimalOrgInvitation, len(invitations))
+			for i, invitation := range invitations {
+				minimalInvitations[i] = convertToMinimalOrgInvitation(invitation)
+			}
+			return MarshalledTextResult(minimalInvitations), nil
+		}
+}
+
+// ListOutsideCollaborators creates a tool to list the outside collaborators of an organization.
+func ListOutsideCollaborators(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_outside_collaborators",
+			mcp.WithDescription(t("TOOL_LIST_OUTSIDE_COLLABORATORS_DESCRIPTION", "List the users who collaborate on repositories of an organization without being members of it.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_OUTSIDE_COLLABORATORS_USER_TITLE", "List outside collaborators"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithString("filter",
+				mcp.Description("Only list collaborators without two-factor authentication with 2fa_disabled (default: all)"),
+				mcp.Enum("all", "2fa_disabled"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			filter, err := OptionalParam[string](request, "filter")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			users, resp, err := client.Organizations.ListOutsideCollaborators(ctx, org, &github.ListOutsideCollaboratorsOptions{
+				Filter: filter,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list outside collaborators", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			minimalUsers := make([]MinimalUser, len(users))
+			for i, user := range users {
+				minimalUsers[i] = MinimalUser{
+					Login:      user.GetLogin(),
+					ID:         user.GetID(),
+					ProfileURL: user.GetHTMLURL(),
+					AvatarURL:  user.GetAvatarURL(),
+				}
+			}
+			return MarshalledTextResult(minimalUsers), nil
+		}
+}
+
+// GetOrgCustomProperties creates a tool to get the custom property definitions of an organization.
+func GetOrgCustomProperties(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_org_custom_properties",
+			mcp.WithDescription(t("TOOL_GET_ORG_CUSTOM_PROPERTIES_DESCRIPTION", "Get the custom properties an organization defines for its repositories: their type, allowed values, default value and whether they are required. Use list_org_custom_property_values to see the values of each repository.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_ORG_CUSTOM_PROPERTIES_USER_TITLE", "Get organization custom properties"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			properties, resp, err := client.Organizations.GetAllCustomProperties(ctx, org)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get custom properties", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(properties), nil
+		}
+}
+
+// RepositoryCustomPropertyValues is the output type for the custom property values of a repository.
+type RepositoryCustomPropertyValues struct {
+	Repository string         `json:"repository"`
+	Properties map[string]any `json:"properties"`
+}
+
+// ListOrgCustomPropertyValues creates a tool to list the custom property values of the repositories of an organization.
+func ListOrgCustomPropertyValues(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_org_custom_property_values",
+			mcp.WithDescription(t("TOOL_LIST_ORG_CUSTOM_PROPERTY_VALUES_DESCRIPTION", "List the custom property values of the repositories of an organization. Properties without a value are omitted.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ORG_CUSTOM_PROPERTY_VALUES_USER_TITLE", "List organization custom property values"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithString("repository_query",
+				mcp.Description("Only list repositories matching this repository search query, e.g. 'props.team:platform' or 'archived:false language:go'"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repositoryQuery, err := OptionalParam[string](request, "repository_query")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			repoValues, resp, err := client.Organizations.ListCustomPropertyValues(ctx, org, &github.ListCustomPropertyValuesOptions{
+				RepositoryQuery: repositoryQuery,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list custom property values", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]RepositoryCustomPropertyValues, len(repoValues))
+			for i, repoValue := range repoValues {
+				result[i] = RepositoryCustomPropertyValues{
+					Repository: repoValue.RepositoryFullName,
+					Properties: map[string]any{},
+				}
+				for _, property := range repoValue.Properties {
+					if property.Value != nil {
+						result[i].Properties[property.PropertyName] = property.Value
+					}
+				}
+			}
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// ListOrgRepositories creates a tool to list the repositories of an organization with filters.
+func ListOrgRepositories(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_org_repositories",
+			mcp.WithDescription(t("TOOL_LIST_ORG_REPOSITORIES_DESCRIPTION", "List the repositories of an organization visible to the authenticated user, filtered by type, archived state, topic or language.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ORG_REPOSITORIES_USER_TITLE", "List organization repositories"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithString("type",
+				mcp.Description("Only list repositories of this type: forks, sources (not forks), or by visibility (default: all)"),
+				mcp.Enum("all", "public", "private", "internal", "forks", "sources"),
+			),
+			mcp.WithBoolean("archived",
+				mcp.Description("Only list archived repositories when true, or only active ones when false. Lists both when omitted"),
+			),
+			mcp.WithString("topic",
+				mcp.Description("Only list repositories with this topic"),
+			),
+			mcp.WithString("language",
+				mcp.Description("Only list repositories with this primary language"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort by last update, stars or forks, in descending order (default: updated)"),
+				mcp.Enum("updated", "stars", "forks"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repoType, err := OptionalParam[string](request, "type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			archived, archivedProvided, err := OptionalParamOK[bool](request, "archived")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			topic, err := OptionalParam[string](request, "topic")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			language, err := OptionalParam[string](request, "language")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sort, err := OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if sort == "" {
+				sort = "updated"
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// The list endpoint can't filter by archived state, topic or language, so the search API is used instead.
+			terms := []string{"org:" + org}
+			switch repoType {
+			case "public", "private", "internal":
+				terms = append(terms, "is:"+repoType, "fork:true")
+			case "forks":
+				terms = append(terms, "fork:only")
+			case "sources":
+				terms = append(terms, "fork:false")
+			default:
+				terms = append(terms, "fork:true")
+			}
+			if archivedProvided {
+				terms = append(terms, fmt.Sprintf("archived:%t", archived))
+			}
+			if topic != "" {
+				terms = append(terms, "topic:"+topic)
+			}
+			if language != "" {
+				terms = append(terms, fmt.Sprintf("language:%q", language))
+			}
+			query := strings.Join(terms, " ")
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			result, resp, err := client.Search.Repositories(ctx, query, &github.SearchOptions{
+				Sort:  sort,
+				Order: "desc",
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list organization repositories", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			minimalRepos := make([]MinimalRepository, 0, len(result.Repositories))
+			for _, repo := range result.Repositories {
+				minimalRepos = append(minimalRepos, convertToMinimalRepository(repo))
+			}
+			return MarshalledTextResult(MinimalSearchRepositoriesResult{
+				TotalCount:        result.GetTotal(),
+				IncompleteResults: result.GetIncompleteResults(),
+				Items:             minimalRepos,
+			}), nil
+		}
+}
+
+// InviteOrgMember creates a tool to invite a user to an organization.
+func InviteOrgMember(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("invite_org_member",
+			mcp.WithDescription(t("TOOL_INVITE_ORG_MEMBER_DESCRIPTION", "Invite a user to join an organization by username or email address, optionally adding them to teams once they accept. Requires being an organization owner.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_INVITE_ORG_MEMBER_USER_TITLE", "Invite organization member"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithString("username",
+				mcp.Description("Username of the user to invite. Either username or email is required"),
+			),
+			mcp.WithString("email",
+				mcp.Description("Email address of the person to invite. Either username or email is required"),
+			),
+			mcp.WithString("role",
+				mcp.Description("Role of the new member (default: direct_member)"),
+				mcp.Enum("direct_member", "admin", "billing_manager"),
+			),
+			mcp.WithArray("team_ids",
+				mcp.Description("IDs of the teams the new member is added to"),
+				mcp.Items(map[string]any{"type": "number"}),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := OptionalParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			email, err := OptionalParam[string](request, "email")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if (username == "") == (email == "") {
+				return mcp.NewToolResultError("exactly one of username or email is required"), nil
+			}
+			role, err := OptionalParam[string](request, "role")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			rawTeamIDs, err := OptionalParam[[]any](request, "team_ids")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamIDs := make([]int64, 0, len(rawTeamIDs))
+			for _, rawTeamID := range rawTeamIDs {
+				teamID, ok := rawTeamID.(float64)
+				if !ok {
+					return mcp.NewToolResultError("team_ids must be an array of numbers"), nil
+				}
+				teamIDs = append(teamIDs, int64(teamID))
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.CreateOrgInvitationOptions{TeamID: teamIDs}
+			if role != "" {
+				opts.Role = github.Ptr(role)
+			}
+			if email != "" {
+				opts.Email = github.Ptr(email)
+			} else {
+				// Invitations take the ID of the user, not their login.
+				user, resp, err := client.Users.Get(ctx, username)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get user", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				opts.InviteeID = github.Ptr(user.GetID())
+			}
+
+			invitation, resp, err := client.Organizations.CreateOrgInvitation(ctx, org, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to invite organization member", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(convertToMinimalOrgInvitation(invitation)), nil
+		}
+}
+
+// RemoveOrgMember creates a tool to remove a member from an organization.
+func RemoveOrgMember(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_org_member",
+			mcp.WithDescription(t("TOOL_REMOVE_ORG_MEMBER_DESCRIPTION", "Remove a user from an organization, or cancel their pending invitation. They lose access to the organization's private repositories and are removed from its teams. Requires being an organization owner.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_REMOVE_ORG_MEMBER_USER_TITLE", "Remove organization member"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Username of the member to remove"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := RequiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Organizations.RemoveOrgMembership(ctx, username, org)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to remove organization member", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("%s was removed from %s", username, org)), nil
+		}
+}
+
+// SetOrgMemberRole creates a tool to change the role of a member of an organization.
+func SetOrgMemberRole(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("set_org_member_role",
+			mcp.WithDescription(t("TOOL_SET_ORG_MEMBER_ROLE_DESCRIPTION", "Make a member of an organization an owner (admin) or a regular member. If the user is not a member yet, they are invited with that role. Requires being an organization owner.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SET_ORG_MEMBER_ROLE_USER_TITLE", "Set organization member role"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Username of the member"),
+			),
+			mcp.WithString("role",
+				mcp.Required(),
+				mcp.Description("New role of the member"),
+				mcp.Enum("admin", "member"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := RequiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			role, err := RequiredParam[string](request, "role")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			membership, resp, err := client.Organizations.EditOrgMembership(ctx, username, org, &github.Membership{
+				Role: github.Ptr(role),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to set organization member role", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(MinimalOrgMember{
+				Login: username,
+				ID:    membership.GetUser().GetID(),
+				Role:  membership.GetRole(),
+			}), nil
+		}
+}
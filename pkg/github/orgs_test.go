@@ -0,0 +1,566 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-http/internal/toolsnaps"
+	"github.com/github/github-mcp-http/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListOrgMembers(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListOrgMembers(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_org_members", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "role")
+	assert.Contains(t, tool.InputSchema.Properties, "filter")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	members := []*github.User{
+		{Login: github.Ptr("octocat"), ID: github.Ptr(int64(1))},
+		{Login: github.Ptr("hubot"), ID: github.Ptr(int64(2))},
+	}
+	owners := []*github.User{
+		{Login: github.Ptr("octocat"), ID: github.Ptr(int64(1))},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectedResult []MinimalOrgMember
+	}{
+		{
+			name: "all members with their roles",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsMembersByOrg,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.URL.Query().Get("role") == "admin" {
+							mockResponse(t, http.StatusOK, owners)(w, r)
+							return
+						}
+						assert.Equal(t, "all", r.URL.Query().Get("role"))
+						mockResponse(t, http.StatusOK, members)(w, r)
+					}),
+				),
+			),
+			args: map[string]interface{}{"org": "org"},
+			expectedResult: []MinimalOrgMember{
+				{Login: "octocat", ID: 1, Role: "admin"},
+				{Login: "hubot", ID: 2, Role: "member"},
+			},
+		},
+		{
+			name: "owners on a later page",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsMembersByOrg,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.URL.Query().Get("role") != "admin" {
+							mockResponse(t, http.StatusOK, members)(w, r)
+							return
+						}
+						if r.URL.Query().Get("page") == "2" {
+							mockResponse(t, http.StatusOK, []*github.User{members[1]})(w, r)
+							return
+						}
+						w.Header().Set("Link", `<https://api.github.com/orgs/org/members?role=admin&page=2>; rel="next"`)
+						mockResponse(t, http.StatusOK, owners)(w, r)
+					}),
+				),
+			),
+			args: map[string]interface{}{"org": "org"},
+			expectedResult: []MinimalOrgMember{
+				{Login: "octocat", ID: 1, Role: "admin"},
+				{Login: "hubot", ID: 2, Role: "admin"},
+			},
+		},
+		{
+			name: "owners only",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsMembersByOrg,
+					expectQueryParams(t, map[string]string{"role": "admin", "page": "1", "per_page": "30"}).andThen(
+						mockResponse(t, http.StatusOK, owners),
+					),
+				),
+			),
+			args: map[string]interface{}{"org": "org", "role": "admin"},
+			expectedResult: []MinimalOrgMember{
+				{Login: "octocat", ID: 1, Role: "admin"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListOrgMembers(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			var returned []MinimalOrgMember
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_ListOrgInvitations(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListOrgInvitations(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_org_invitations", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedResult []MinimalOrgInvitation
+		expectedErrMsg string
+	}{
+		{
+			name: "pending invitations",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetOrgsInvitationsByOrg,
+					[]*github.Invitation{
+						{
+							ID:        github.Ptr(int64(7)),
+							Login:     github.Ptr("hubot"),
+							Role:      github.Ptr("direct_member"),
+							Inviter:   &github.User{Login: github.Ptr("octocat")},
+							TeamCount: github.Ptr(2),
+						},
+						{
+							ID:    github.Ptr(int64(8)),
+							Email: github.Ptr("someone@example.com"),
+							Role:  github.Ptr("admin"),
+						},
+					},
+				),
+			),
+			expectedResult: []MinimalOrgInvitation{
+				{ID: 7, Login: "hubot", Role: "direct_member", Inviter: "octocat", TeamCount: 2},
+				{ID: 8, Email: "someone@example.com", Role: "admin"},
+			},
+		},
+		{
+			name: "not an owner",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsInvitationsByOrg,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Must be an organization owner"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to list organization invitations",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListOrgInvitations(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{"org": "org"}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned []MinimalOrgInvitation
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_ListOutsideCollaborators(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListOutsideCollaborators(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_outside_collaborators", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetOrgsOutsideCollaboratorsByOrg,
+			expectQueryParams(t, map[string]string{"filter": "2fa_disabled", "page": "1", "per_page": "30"}).andThen(
+				mockResponse(t, http.StatusOK, []*github.User{{Login: github.Ptr("contractor"), ID: github.Ptr(int64(3))}}),
+			),
+		),
+	))
+	_, handler := ListOutsideCollaborators(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"org":    "org",
+		"filter": "2fa_disabled",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	var returned []MinimalUser
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+	assert.Equal(t, []MinimalUser{{Login: "contractor", ID: 3}}, returned)
+}
+
+func Test_GetOrgCustomProperties(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetOrgCustomProperties(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_org_custom_properties", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetOrgsPropertiesSchemaByOrg,
+			[]*github.CustomProperty{
+				{
+					PropertyName:  github.Ptr("team"),
+					ValueType:     "single_select",
+					Required:      github.Ptr(true),
+					AllowedValues: []string{"platform", "web"},
+				},
+			},
+		),
+	))
+	_, handler := GetOrgCustomProperties(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{"org": "org"}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	var returned []*github.CustomProperty
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+	require.Len(t, returned, 1)
+	assert.Equal(t, "team", returned[0].GetPropertyName())
+	assert.Equal(t, []string{"platform", "web"}, returned[0].AllowedValues)
+}
+
+func Test_ListOrgCustomPropertyValues(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListOrgCustomPropertyValues(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_org_custom_property_values", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetOrgsPropertiesValuesByOrg,
+			expectQueryParams(t, map[string]string{"repository_query": "props.team:platform", "page": "1", "per_page": "30"}).andThen(
+				mockResponse(t, http.StatusOK, []map[string]any{
+					{
+						"repository_id":        1,
+						"repository_name":      "api",
+						"repository_full_name": "org/api",
+						"properties": []map[string]any{
+							{"property_name": "team", "value": "platform"},
+							{"property_name": "tier", "value": nil},
+							{"property_name": "regions", "value": []string{"eu", "us"}},
+						},
+					},
+				}),
+			),
+		),
+	))
+	_, handler := ListOrgCustomPropertyValues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"org":              "org",
+		"repository_query": "props.team:platform",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	assert.JSONEq(t, `[{"repository":"org/api","properties":{"team":"platform","regions":["eu","us"]}}]`, textContent.Text)
+}
+
+func Test_ListOrgRepositories(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListOrgRepositories(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_org_repositories", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "type")
+	assert.Contains(t, tool.InputSchema.Properties, "archived")
+	assert.Contains(t, tool.InputSchema.Properties, "topic")
+	assert.Contains(t, tool.InputSchema.Properties, "language")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	searchResult := &github.RepositoriesSearchResult{
+		Total: github.Ptr(1),
+		Repositories: []*github.Repository{
+			{
+				ID:       github.Ptr(int64(1)),
+				Name:     github.Ptr("api"),
+				FullName: github.Ptr("org/api"),
+				Language: github.Ptr("Go"),
+				Topics:   []string{"platform"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedQuery string
+	}{
+		{
+			name:          "all repositories",
+			args:          map[string]interface{}{"org": "org"},
+			expectedQuery: "org:org fork:true",
+		},
+		{
+			name: "filtered repositories",
+			args: map[string]interface{}{
+				"org":      "org",
+				"type":     "sources",
+				"archived": false,
+				"topic":    "platform",
+				"language": "Go",
+			},
+			expectedQuery: `org:org fork:false archived:false topic:platform language:"Go"`,
+		},
+		{
+			name: "private repositories",
+			args: map[string]interface{}{
+				"org":  "org",
+				"type": "private",
+			},
+			expectedQuery: "org:org is:private fork:true",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchRepositories,
+					expectQueryParams(t, map[string]string{
+						"q":        tc.expectedQuery,
+						"sort":     "updated",
+						"order":    "desc",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, searchResult),
+					),
+				),
+			))
+			_, handler := ListOrgRepositories(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			var returned MinimalSearchRepositoriesResult
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, 1, returned.TotalCount)
+			require.Len(t, returned.Items, 1)
+			assert.Equal(t, "org/api", returned.Items[0].FullName)
+			assert.Equal(t, []string{"platform"}, returned.Items[0].Topics)
+		})
+	}
+}
+
+func Test_InviteOrgMember(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := InviteOrgMember(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "invite_org_member", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	invitation := &github.Invitation{ID: github.Ptr(int64(7)), Login: github.Ptr("hubot"), Role: github.Ptr("direct_member")}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "invite by username",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetUsersByUsername,
+					github.User{Login: github.Ptr("hubot"), ID: github.Ptr(int64(42))},
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsInvitationsByOrg,
+					expectRequestBody(t, map[string]any{
+						"invitee_id": float64(42),
+						"team_ids":   []any{float64(3), float64(4)},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, invitation),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"org":      "org",
+				"username": "hubot",
+				"team_ids": []interface{}{float64(3), float64(4)},
+			},
+		},
+		{
+			name: "invite by email",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsInvitationsByOrg,
+					expectRequestBody(t, map[string]any{
+						"email": "hubot@example.com",
+						"role":  "admin",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, invitation),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"org":   "org",
+				"email": "hubot@example.com",
+				"role":  "admin",
+			},
+		},
+		{
+			name:         "username and email",
+			mockedClient: mock.NewMockedHTTPClient(),
+			args: map[string]interface{}{
+				"org":      "org",
+				"username": "hubot",
+				"email":    "hubot@example.com",
+			},
+			expectError:    true,
+			expectedErrMsg: "exactly one of username or email is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := InviteOrgMember(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned MinimalOrgInvitation
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, int64(7), returned.ID)
+		})
+	}
+}
+
+func Test_RemoveOrgMember(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RemoveOrgMember(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "remove_org_member", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "username"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteOrgsMembershipsByOrgByUsername,
+			expectPath(t, "/orgs/org/memberships/hubot").andThen(
+				mockResponse(t, http.StatusNoContent, ""),
+			),
+		),
+	))
+	_, handler := RemoveOrgMember(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"org":      "org",
+		"username": "hubot",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	assert.Equal(t, "hubot was removed from org", textContent.Text)
+}
+
+func Test_SetOrgMemberRole(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SetOrgMemberRole(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "set_org_member_role", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "username", "role"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PutOrgsMembershipsByOrgByUsername,
+			expect(t, expectations{
+				path:        "/orgs/org/memberships/hubot",
+				requestBody: map[string]any{"role": "admin"},
+			}).andThen(
+				mockResponse(t, http.StatusOK, &github.Membership{
+					Role:  github.Ptr("admin"),
+					State: github.Ptr("active"),
+					User:  &github.User{Login: github.Ptr("hubot"), ID: github.Ptr(int64(42))},
+				}),
+			),
+		),
+	))
+	_, handler := SetOrgMemberRole(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"org":      "org",
+		"username": "hubot",
+		"role":     "admin",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	var returned MinimalOrgMember
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+	assert.Equal(t, MinimalOrgMember{Login: "hubot", ID: 42, Role: "admin"}, returned)
+}
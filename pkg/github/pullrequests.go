@@ -3,9 +3,12 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/google/go-github/v74/github"
@@ -239,7 +242,7 @@ func UpdatePullRequest(getClient GetClientFn, getGQLClient GetGQLClientFn, t tra
 				mcp.Enum("open", "closed"),
 			),
 			mcp.WithBoolean("draft",
-				mcp.Description("Mark pull request as draft (true) or ready for review (false)"),
+				mcp.Description("Convert the pull request to a draft (true) or mark it ready for review (false). Only open pull requests can change draft state"),
 			),
 			mcp.WithString("base",
 				mcp.Description("New base branch name"),
@@ -296,9 +299,10 @@ func UpdatePullRequest(getClient GetClientFn, getGQLClient GetGQLClientFn, t tra
 				restUpdateNeeded = true
 			}
 
-			if state, ok, err := OptionalParamOK[string](request, "state"); err != nil {
+			state, stateProvided, err := OptionalParamOK[string](request, "state")
+			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
-			} else if ok {
+			} else if stateProvided {
 				update.State = github.Ptr(state)
 				restUpdateNeeded = true
 			}
@@ -328,6 +332,31 @@ func UpdatePullRequest(getClient GetClientFn, getGQLClient GetGQLClientFn, t tra
 				return mcp.NewToolResultError("No update parameters provided."), nil
 			}
 
+			// The draft change is only applied after the REST update, so it is checked first to
+			// not leave a half-applied update behind when the pull request cannot change draft state.
+			var gqlClient *githubv4.Client
+			var draftPR *draftablePullRequest
+			if draftProvided {
+				gqlClient, err = getGQLClient(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+				}
+
+				var result *mcp.CallToolResult
+				draftPR, result = getDraftablePullRequest(ctx, gqlClient, owner, repo, pullNumber)
+				if result != nil {
+					return result, nil
+				}
+				if bool(draftPR.IsDraft) != draftValue {
+					switch {
+					case state == "closed":
+						return mcp.NewToolResultError("cannot change the draft state of a pull request while closing it"), nil
+					case state != "open" && draftPR.State != githubv4.PullRequestStateOpen:
+						return mcp.NewToolResultError(fmt.Sprintf("cannot change the draft state of a %s pull request", strings.ToLower(string(draftPR.State)))), nil
+					}
+				}
+			}
+
 			// Handle REST API updates (title, body, state, base, maintainer_can_modify)
 			if restUpdateNeeded {
 				client, err := getClient(ctx)
@@ -354,70 +383,14 @@ func UpdatePullRequest(getClient GetClientFn, getGQLClient GetGQLClientFn, t tra
 				}
 			}
 
-			// Handle draft status changes using GraphQL
+			// The REST API ignores draft changes, so they go through GraphQL.
+			var draftResult *bool
 			if draftProvided {
-				gqlClient, err := getGQLClient(ctx)
-				if err != nil {
-					return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
-				}
-
-				var prQuery struct {
-					Repository struct {
-						PullRequest struct {
-							ID      githubv4.ID
-							IsDraft githubv4.Boolean
-						} `graphql:"pullRequest(number: $prNum)"`
-					} `graphql:"repository(owner: $owner, name: $repo)"`
-				}
-
-				err = gqlClient.Query(ctx, &prQuery, map[string]interface{}{
-					"owner": githubv4.String(owner),
-					"repo":  githubv4.String(repo),
-					"prNum": githubv4.Int(pullNumber), // #nosec G115 - pull request numbers are always small positive integers
-				})
-				if err != nil {
-					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to find pull request", err), nil
-				}
-
-				currentIsDraft := bool(prQuery.Repository.PullRequest.IsDraft)
-
-				if currentIsDraft != draftValue {
-					if draftValue {
-						// Convert to draft
-						var mutation struct {
-							ConvertPullRequestToDraft struct {
-								PullRequest struct {
-									ID      githubv4.ID
-									IsDraft githubv4.Boolean
-								}
-							} `graphql:"convertPullRequestToDraft(input: $input)"`
-						}
-
-						err = gqlClient.Mutate(ctx, &mutation, githubv4.ConvertPullRequestToDraftInput{
-							PullRequestID: prQuery.Repository.PullRequest.ID,
-						}, nil)
-						if err != nil {
-							return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to convert pull request to draft", err), nil
-						}
-					} else {
-						// Mark as ready for review
-						var mutation struct {
-							MarkPullRequestReadyForReview struct {
-								PullRequest struct {
-									ID      githubv4.ID
-									IsDraft githubv4.Boolean
-								}
-							} `graphql:"markPullRequestReadyForReview(input: $input)"`
-						}
-
-						err = gqlClient.Mutate(ctx, &mutation, githubv4.MarkPullRequestReadyForReviewInput{
-							PullRequestID: prQuery.Repository.PullRequest.ID,
-						}, nil)
-						if err != nil {
-							return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to mark pull request ready for review", err), nil
-						}
-					}
+				isDraft, result := setPullRequestDraft(ctx, gqlClient, draftPR, draftValue)
+				if result != nil {
+					return result, nil
 				}
+				draftResult = &isDraft
 			}
 
 			// Handle reviewer requests
@@ -471,9 +444,15 @@ func UpdatePullRequest(getClient GetClientFn, getGQLClient GetGQLClientFn, t tra
 			}()
 
 			// Return minimal response with just essential information
-			minimalResponse := MinimalResponse{
-				ID:  fmt.Sprintf("%d", finalPR.GetID()),
-				URL: finalPR.GetHTMLURL(),
+			minimalResponse := MinimalPullRequestUpdateResponse{
+				ID:    fmt.Sprintf("%d", finalPR.GetID()),
+				URL:   finalPR.GetHTMLURL(),
+				State: finalPR.GetState(),
+				Draft: finalPR.GetDraft(),
+			}
+			if draftResult != nil {
+				// The REST API can briefly lag behind the GraphQL mutation, so trust the mutation result.
+				minimalResponse.Draft = *draftResult
 			}
 
 			r, err := json.Marshal(minimalResponse)
@@ -485,6 +464,83 @@ func UpdatePullRequest(getClient GetClientFn, getGQLClient GetGQLClientFn, t tra
 		}
 }
 
+// draftablePullRequest is the part of a pull request needed to change its draft state.
+type draftablePullRequest struct {
+	ID      githubv4.ID
+	IsDraft githubv4.Boolean
+	State   githubv4.PullRequestState
+}
+
+// getDraftablePullRequest looks up the pull request whose draft state is about to change.
+func getDraftablePullRequest(ctx context.Context, client *githubv4.Client, owner, repo string, pullNumber int) (*draftablePullRequest, *mcp.CallToolResult) {
+	var prQuery struct {
+		Repository struct {
+			PullRequest draftablePullRequest `graphql:"pullRequest(number: $prNum)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	err := client.Query(ctx, &prQuery, map[string]interface{}{
+		"owner": githubv4.String(owner),
+		"repo":  githubv4.String(repo),
+		"prNum": githubv4.Int(pullNumber), // #nosec G115 - pull request numbers are always small positive integers
+	})
+	if err != nil {
+		return nil, ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to find pull request", err)
+	}
+	return &prQuery.Repository.PullRequest, nil
+}
+
+// setPullRequestDraft converts a pull request to a draft or marks it ready for review, and returns
+// the draft state GitHub reports afterwards. Pull requests already in the requested state are left as they are.
+// Callers check that the pull request is open, as only open pull requests can change draft state.
+func setPullRequestDraft(ctx context.Context, client *githubv4.Client, pr *draftablePullRequest, draft bool) (bool, *mcp.CallToolResult) {
+	if bool(pr.IsDraft) == draft {
+		return draft, nil
+	}
+
+	var isDraft githubv4.Boolean
+	if draft {
+		var mutation struct {
+			ConvertPullRequestToDraft struct {
+				PullRequest struct {
+					ID      githubv4.ID
+					IsDraft githubv4.Boolean
+				}
+			} `graphql:"convertPullRequestToDraft(input: $input)"`
+		}
+
+		err := client.Mutate(ctx, &mutation, githubv4.ConvertPullRequestToDraftInput{
+			PullRequestID: pr.ID,
+		}, nil)
+		if err != nil {
+			return false, ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to convert pull request to draft", err)
+		}
+		isDraft = mutation.ConvertPullRequestToDraft.PullRequest.IsDraft
+	} else {
+		var mutation struct {
+			MarkPullRequestReadyForReview struct {
+				PullRequest struct {
+					ID      githubv4.ID
+					IsDraft githubv4.Boolean
+				}
+			} `graphql:"markPullRequestReadyForReview(input: $input)"`
+		}
+
+		err := client.Mutate(ctx, &mutation, githubv4.MarkPullRequestReadyForReviewInput{
+			PullRequestID: pr.ID,
+		}, nil)
+		if err != nil {
+			return false, ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to mark pull request ready for review", err)
+		}
+		isDraft = mutation.MarkPullRequestReadyForReview.PullRequest.IsDraft
+	}
+
+	if bool(isDraft) != draft {
+		return false, mcp.NewToolResultError(fmt.Sprintf("GitHub did not apply the draft change: pull request is still draft=%t", bool(isDraft)))
+	}
+	return draft, nil
+}
+
 // ListPullRequests creates a tool to list and filter repository pull requests.
 func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	return mcp.NewTool("list_pull_requests",
@@ -1870,6 +1926,1175 @@ func RequestCopilotReview(getClient GetClientFn, t translations.TranslationHelpe
 		}
 }
 
+// ListPullRequestCommits creates a tool to list the commits of a pull request.
+func ListPullRequestCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_pull_request_commits",
+			mcp.WithDescription(t("TOOL_LIST_PULL_REQUEST_COMMITS_DESCRIPTION", "List the commits of a specific pull request, oldest first.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PULL_REQUEST_COMMITS_USER_TITLE", "List pull request commits"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			opts := &github.ListOptions{
+				PerPage: pagination.PerPage,
+				Page:    pagination.Page,
+			}
+			commits, resp, err := client.PullRequests.ListCommits(ctx, owner, repo, pullNumber, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list pull request commits",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			minimalCommits := make([]MinimalCommit, len(commits))
+			for i, commit := range commits {
+				minimalCommits[i] = convertToMinimalCommit(commit, false)
+			}
+
+			return MarshalledTextResult(minimalCommits), nil
+		}
+}
+
+// GetPullRequestTimeline creates a tool to get the timeline of events of a pull request.
+func GetPullRequestTimeline(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pull_request_timeline",
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_TIMELINE_DESCRIPTION", "Get the timeline of a specific pull request: commits, reviews, comments, label and assignee changes, review requests, cross-references and state changes, oldest first.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PULL_REQUEST_TIMELINE_USER_TITLE", "Get pull request timeline"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			opts := &github.ListOptions{
+				PerPage: pagination.PerPage,
+				Page:    pagination.Page,
+			}
+			// Pull requests share their number and timeline with the underlying issue.
+			events, resp, err := client.Issues.ListIssueTimeline(ctx, owner, repo, pullNumber, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get pull request timeline",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			minimalEvents := make([]MinimalTimelineEvent, len(events))
+			for i, event := range events {
+				minimalEvents[i] = convertToMinimalTimelineEvent(event)
+			}
+
+			return MarshalledTextResult(minimalEvents), nil
+		}
+}
+
+// checkStateMissing marks a required check that has not reported on the head commit.
+const checkStateMissing = "missing"
+
+// checkStateUnknown marks a required check that was not found among the checks that could be read.
+const checkStateUnknown = "unknown"
+
+// GetPullRequestMergeability creates a tool that reports whether a pull request can be merged and what blocks it.
+func GetPullRequestMergeability(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pull_request_mergeability",
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_MERGEABILITY_DESCRIPTION", "Report whether a pull request can be merged. Combines the mergeable state, draft status, merge conflicts, required reviews and required status checks from branch protection and rulesets into a single list of blockers.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PULL_REQUEST_MERGEABILITY_USER_TITLE", "Get pull request mergeability"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get pull request",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			report := &PullRequestMergeability{
+				Draft:              pr.GetDraft(),
+				Mergeable:          pr.Mergeable,
+				MergeableState:     pr.GetMergeableState(),
+				BaseBranch:         pr.GetBase().GetRef(),
+				HeadSHA:            pr.GetHead().GetSHA(),
+				Blockers:           []string{},
+				Approvals:          []string{},
+				ChangesRequestedBy: []string{},
+				RequiredChecks:     []RequiredCheck{},
+			}
+
+			requirements, result := branchMergeRequirements(ctx, client, owner, repo, report.BaseBranch, report)
+			if result != nil {
+				return result, nil
+			}
+
+			// Every page is read, as the latest verdict of a reviewer may be on any of them.
+			var reviews []*github.PullRequestReview
+			opts := &github.ListOptions{PerPage: 100}
+			for {
+				page, resp, err := client.PullRequests.ListReviews(ctx, owner, repo, pullNumber, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to get pull request reviews",
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				reviews = append(reviews, page...)
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+			report.addReviews(reviews)
+
+			checks, truncated, result := listCheckResults(ctx, client, owner, repo, report.HeadSHA)
+			if result != nil {
+				return result, nil
+			}
+			report.ChecksTruncated = truncated
+			report.addRequiredChecks(requirements.checks, checks)
+
+			report.finish(pr)
+			return MarshalledTextResult(report), nil
+		}
+}
+
+// mergeRequirements are the merge requirements of a branch, combined from branch protection and rulesets.
+type mergeRequirements struct {
+	checks []string
+}
+
+// branchMergeRequirements collects the review and status check requirements of a branch from its
+// branch protection and the rulesets that apply to it. Requirements the token is not allowed to read
+// are recorded as notes on the report rather than failing the whole report.
+func branchMergeRequirements(ctx context.Context, client *github.Client, owner, repo, branch string, report *PullRequestMergeability) (*mergeRequirements, *mcp.CallToolResult) {
+	requirements := &mergeRequirements{}
+	seen := map[string]bool{}
+	addCheck := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			requirements.checks = append(requirements.checks, name)
+		}
+	}
+
+	protection, resp, err := client.Repositories.GetBranchProtection(ctx, owner, repo, branch)
+	switch {
+	case errors.Is(err, github.ErrBranchNotProtected):
+		// Nothing to add: the branch has no classic branch protection.
+	case err != nil && resp != nil && (resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusNotFound):
+		report.Notes = append(report.Notes, "branch protection could not be read with this token; only rulesets are taken into account")
+	case err != nil:
+		return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get branch protection", resp, err)
+	default:
+		_ = resp.Body.Close()
+		if reviews := protection.GetRequiredPullRequestReviews(); reviews != nil {
+			report.RequiredApprovals = max(report.RequiredApprovals, reviews.RequiredApprovingReviewCount)
+			report.CodeOwnerReview = report.CodeOwnerReview || reviews.RequireCodeOwnerReviews
+		}
+		if statusChecks := protection.GetRequiredStatusChecks(); statusChecks != nil {
+			if statusChecks.Contexts != nil {
+				for _, name := range *statusChecks.Contexts {
+					addCheck(name)
+				}
+			}
+			if statusChecks.Checks != nil {
+				for _, check := range *statusChecks.Checks {
+					addCheck(check.Context)
+				}
+			}
+		}
+		if resolution := protection.GetRequiredConversationResolution(); resolution != nil && resolution.Enabled {
+			report.ConversationResolution = true
+		}
+	}
+
+	// Unlike GetBranchProtection, GetRulesForBranch does not escape the branch name.
+	rules, resp, err := client.Repositories.GetRulesForBranch(ctx, owner, repo, url.PathEscape(branch), &github.ListOptions{PerPage: 100})
+	switch {
+	case err != nil && resp != nil && (resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusNotFound):
+		report.Notes = append(report.Notes, "rulesets could not be read with this token; only branch protection is taken into account")
+	case err != nil:
+		return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get branch rules", resp, err)
+	default:
+		_ = resp.Body.Close()
+		for _, rule := range rules.PullRequest {
+			report.RequiredApprovals = max(report.RequiredApprovals, rule.Parameters.RequiredApprovingReviewCount)
+			report.CodeOwnerReview = report.CodeOwnerReview || rule.Parameters.RequireCodeOwnerReview
+			report.ConversationResolution = report.ConversationResolution || rule.Parameters.RequiredReviewThreadResolution
+		}
+		for _, rule := range rules.RequiredStatusChecks {
+			for _, check := range rule.Parameters.RequiredStatusChecks {
+				addCheck(check.Context)
+			}
+		}
+	}
+
+	return requirements, nil
+}
+
+// addReviews records the approvals and change requests from the latest review of each reviewer.
+// reviews must be in chronological order, as returned by the API.
+func (r *PullRequestMergeability) addReviews(reviews []*github.PullRequestReview) {
+	latest := map[string]string{}
+	var reviewers []string
+	for _, review := range reviews {
+		state := review.GetState()
+		// Comments neither approve nor request changes, so they do not replace an earlier verdict.
+		if state != "APPROVED" && state != "CHANGES_REQUESTED" && state != "DISMISSED" {
+			continue
+		}
+		login := review.GetUser().GetLogin()
+		if _, ok := latest[login]; !ok {
+			reviewers = append(reviewers, login)
+		}
+		latest[login] = state
+	}
+	for _, login := range reviewers {
+		switch latest[login] {
+		case "APPROVED":
+			r.Approvals = append(r.Approvals, login)
+		case "CHANGES_REQUESTED":
+			r.ChangesRequestedBy = append(r.ChangesRequestedBy, login)
+		}
+	}
+}
+
+// addRequiredChecks resolves the state of each required check from the checks reported on the head commit.
+func (r *PullRequestMergeability) addRequiredChecks(required []string, checks []checkResult) {
+	states := map[string]string{}
+	for _, check := range checks {
+		switch states[check.name] {
+		case checkStateFailing:
+		case checkStatePending:
+			if check.state == checkStateFailing {
+				states[check.name] = check.state
+			}
+		default:
+			states[check.name] = check.state
+		}
+	}
+	for _, name := range required {
+		state, ok := states[name]
+		switch {
+		case !ok && r.ChecksTruncated:
+			// The check may be among those that could not be read.
+			state = checkStateUnknown
+		case !ok:
+			state = checkStateMissing
+		}
+		r.RequiredChecks = append(r.RequiredChecks, RequiredCheck{Name: name, State: state})
+	}
+}
+
+// finish derives the blockers and overall state of the report.
+func (r *PullRequestMergeability) finish(pr *github.PullRequest) {
+	switch {
+	case pr.GetMerged():
+		r.Blockers = append(r.Blockers, "pull request is already merged")
+	case pr.GetState() == "closed":
+		r.Blockers = append(r.Blockers, "pull request is closed")
+	}
+	if r.Draft {
+		r.Blockers = append(r.Blockers, "pull request is a draft; mark it ready for review")
+	}
+	switch {
+	case r.Mergeable == nil:
+		r.Notes = append(r.Notes, "GitHub is still computing mergeability; try again shortly")
+	case !*r.Mergeable || r.MergeableState == "dirty":
+		r.Blockers = append(r.Blockers, "pull request has merge conflicts with the base branch")
+	}
+	if r.ChecksTruncated {
+		r.Notes = append(r.Notes, "not all checks on the head commit could be read")
+	}
+	if r.MergeableState == "behind" {
+		r.Blockers = append(r.Blockers, "head branch is behind the base branch; update the branch")
+	}
+	if len(r.Approvals) < r.RequiredApprovals {
+		r.Blockers = append(r.Blockers, fmt.Sprintf("%d of %d required approving reviews", len(r.Approvals), r.RequiredApprovals))
+	}
+	if len(r.ChangesRequestedBy) > 0 {
+		r.Blockers = append(r.Blockers, fmt.Sprintf("changes requested by %s", strings.Join(r.ChangesRequestedBy, ", ")))
+	}
+	for _, check := range r.RequiredChecks {
+		if check.State != checkStatePassing {
+			r.Blockers = append(r.Blockers, fmt.Sprintf("required check %q is %s", check.Name, check.State))
+		}
+	}
+	if r.MergeableState == "blocked" && len(r.Blockers) == 0 {
+		// GitHub knows about a requirement this report could not see, such as code owner review.
+		r.Blockers = append(r.Blockers, "merging is blocked by a branch requirement, such as code owner review or resolved conversations")
+	}
+
+	r.CanMerge = len(r.Blockers) == 0 && r.Mergeable != nil
+	switch {
+	case r.CanMerge:
+		r.State = "ready"
+	case r.Mergeable == nil && len(r.Blockers) == 0:
+		r.State = "unknown"
+	default:
+		r.State = "blocked"
+	}
+}
+
+// ReviewThreadCommentFragment represents a comment of a pull request review thread in the GraphQL API.
+type ReviewThreadCommentFragment struct {
+	ID         githubv4.ID
+	DatabaseID githubv4.Int
+	Body       githubv4.String
+	Author     struct {
+		Login githubv4.String
+	}
+	CreatedAt githubv4.DateTime
+	URL       githubv4.URI
+}
+
+// ReviewThreadFragment represents a pull request review thread in the GraphQL API.
+type ReviewThreadFragment struct {
+	ID           githubv4.ID
+	IsResolved   githubv4.Boolean
+	IsOutdated   githubv4.Boolean
+	IsCollapsed  githubv4.Boolean
+	Path         githubv4.String
+	Line         *githubv4.Int
+	StartLine    *githubv4.Int
+	OriginalLine *githubv4.Int
+	DiffSide     githubv4.DiffSide
+	SubjectType  githubv4.PullRequestReviewThreadSubjectType
+	ResolvedBy   struct {
+		Login githubv4.String
+	}
+	Comments struct {
+		TotalCount githubv4.Int
+		Nodes      []ReviewThreadCommentFragment
+	} `graphql:"comments(first: $commentsFirst)"`
+}
+
+// ListReviewThreadsQuery is the root query structure for fetching the review threads of a pull request.
+type ListReviewThreadsQuery struct {
+	Repository struct {
+		PullRequest struct {
+			ReviewThreads struct {
+				Nodes    []ReviewThreadFragment
+				PageInfo struct {
+					HasNextPage     githubv4.Boolean
+					HasPreviousPage githubv4.Boolean
+					StartCursor     githubv4.String
+					EndCursor       githubv4.String
+				}
+				TotalCount githubv4.Int
+			} `graphql:"reviewThreads(first: $first, after: $after)"`
+		} `graphql:"pullRequest(number: $prNum)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// ReviewThreadComment is the output type for a comment of a pull request review thread.
+type ReviewThreadComment struct {
+	ID         string `json:"id"`
+	DatabaseID int64  `json:"databaseId"`
+	Author     string `json:"author"`
+	Body       string `json:"body"`
+	CreatedAt  string `json:"createdAt"`
+	URL        string `json:"url"`
+}
+
+// ReviewThread is the output type for a pull request review thread.
+type ReviewThread struct {
+	ID            string                `json:"id"`
+	IsResolved    bool                  `json:"isResolved"`
+	IsOutdated    bool                  `json:"isOutdated"`
+	IsCollapsed   bool                  `json:"isCollapsed"`
+	Path          string                `json:"path"`
+	Line          *int                  `json:"line,omitempty"`
+	StartLine     *int                  `json:"startLine,omitempty"`
+	OriginalLine  *int                  `json:"originalLine,omitempty"`
+	DiffSide      string                `json:"diffSide"`
+	SubjectType   string                `json:"subjectType"`
+	ResolvedBy    string                `json:"resolvedBy,omitempty"`
+	CommentsCount int                   `json:"commentsCount"`
+	Comments      []ReviewThreadComment `json:"comments"`
+}
+
+func convertToReviewThread(fragment ReviewThreadFragment) ReviewThread {
+	gqlIntPtr := func(i *githubv4.Int) *int {
+		if i == nil {
+			return nil
+		}
+		v := int(*i)
+		return &v
+	}
+
+	thread := ReviewThread{
+		ID:            fmt.Sprint(fragment.ID),
+		IsResolved:    bool(fragment.IsResolved),
+		IsOutdated:    bool(fragment.IsOutdated),
+		IsCollapsed:   bool(fragment.IsCollapsed),
+		Path:          string(fragment.Path),
+		Line:          gqlIntPtr(fragment.Line),
+		StartLine:     gqlIntPtr(fragment.StartLine),
+		OriginalLine:  gqlIntPtr(fragment.OriginalLine),
+		DiffSide:      string(fragment.DiffSide),
+		SubjectType:   string(fragment.SubjectType),
+		ResolvedBy:    string(fragment.ResolvedBy.Login),
+		CommentsCount: int(fragment.Comments.TotalCount),
+		Comments:      make([]ReviewThreadComment, 0, len(fragment.Comments.Nodes)),
+	}
+	for _, comment := range fragment.Comments.Nodes {
+		thread.Comments = append(thread.Comments, ReviewThreadComment{
+			ID:         fmt.Sprint(comment.ID),
+			DatabaseID: int64(comment.DatabaseID),
+			Author:     string(comment.Author.Login),
+			Body:       string(comment.Body),
+			CreatedAt:  comment.CreatedAt.Format("2006-01-02T15:04:05Z"),
+			URL:        comment.URL.String(),
+		})
+	}
+	return thread
+}
+
+// ListPullRequestReviewThreads creates a tool to list the review threads of a pull request.
+func ListPullRequestReviewThreads(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_pull_request_review_threads",
+			mcp.WithDescription(t("TOOL_LIST_PULL_REQUEST_REVIEW_THREADS_DESCRIPTION", "List the review threads of a pull request with their resolution and outdated state, file path, line and comments. Use the thread IDs to reply to, resolve or unresolve threads.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PULL_REQUEST_REVIEW_THREADS_USER_TITLE", "List pull request review threads"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithNumber("commentsPerThread",
+				mcp.Description("Maximum number of comments returned for each thread (min 1, max 100, default 30)"),
+				mcp.Min(1),
+				mcp.Max(100),
+			),
+			WithCursorPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commentsPerThread, err := OptionalIntParamWithDefault(request, "commentsPerThread", DefaultGraphQLPageSize)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if commentsPerThread < 1 || commentsPerThread > 100 {
+				return mcp.NewToolResultError("commentsPerThread must be between 1 and 100"), nil
+			}
+			pagination, err := OptionalCursorPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			paginationParams, err := pagination.ToGraphQLParams()
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			var query ListReviewThreadsQuery
+			vars := map[string]any{
+				"owner":         githubv4.String(owner),
+				"repo":          githubv4.String(repo),
+				"prNum":         githubv4.Int(pullNumber), // #nosec G115 - pull request numbers are always small positive integers
+				"first":         githubv4.Int(*paginationParams.First),
+				"commentsFirst": githubv4.Int(commentsPerThread), // #nosec G115 - bounded to 100 above
+				"after":         (*githubv4.String)(nil),
+			}
+			if paginationParams.After != nil {
+				vars["after"] = githubv4.String(*paginationParams.After)
+			}
+			if err := client.Query(ctx, &query, vars); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to list pull request review threads", err), nil
+			}
+
+			reviewThreads := query.Repository.PullRequest.ReviewThreads
+			threads := make([]ReviewThread, 0, len(reviewThreads.Nodes))
+			for _, node := range reviewThreads.Nodes {
+				threads = append(threads, convertToReviewThread(node))
+			}
+
+			return MarshalledTextResult(map[string]any{
+				"threads": threads,
+				"pageInfo": map[string]any{
+					"hasNextPage":     reviewThreads.PageInfo.HasNextPage,
+					"hasPreviousPage": reviewThreads.PageInfo.HasPreviousPage,
+					"startCursor":     string(reviewThreads.PageInfo.StartCursor),
+					"endCursor":       string(reviewThreads.PageInfo.EndCursor),
+				},
+				"totalCount": reviewThreads.TotalCount,
+			}), nil
+		}
+}
+
+// ReplyToPullRequestReviewThread creates a tool to reply to an existing pull request review thread.
+func ReplyToPullRequestReviewThread(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("reply_to_pull_request_review_thread",
+			mcp.WithDescription(t("TOOL_REPLY_TO_PULL_REQUEST_REVIEW_THREAD_DESCRIPTION", "Reply to an existing pull request review thread. The reply is published immediately, outside of any pending review.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_REPLY_TO_PULL_REQUEST_REVIEW_THREAD_USER_TITLE", "Reply to pull request review thread"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("threadId",
+				mcp.Required(),
+				mcp.Description("The node ID of the review thread, as returned by list_pull_request_review_threads"),
+			),
+			mcp.WithString("body",
+				mcp.Required(),
+				mcp.Description("The text of the reply"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			threadID, err := RequiredParam[string](request, "threadId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := RequiredParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			var mutation struct {
+				AddPullRequestReviewThreadReply struct {
+					Comment struct {
+						ID  githubv4.ID
+						URL githubv4.URI
+					}
+				} `graphql:"addPullRequestReviewThreadReply(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, githubv4.AddPullRequestReviewThreadReplyInput{
+				PullRequestReviewThreadID: githubv4.ID(threadID),
+				Body:                      githubv4.String(body),
+			}, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to reply to pull request review thread", err), nil
+			}
+
+			comment := mutation.AddPullRequestReviewThreadReply.Comment
+			return MarshalledTextResult(MinimalResponse{
+				ID:  fmt.Sprint(comment.ID),
+				URL: comment.URL.String(),
+			}), nil
+		}
+}
+
+// ResolvePullRequestReviewThread creates a tool to mark a pull request review thread as resolved.
+func ResolvePullRequestReviewThread(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("resolve_pull_request_review_thread",
+			mcp.WithDescription(t("TOOL_RESOLVE_PULL_REQUEST_REVIEW_THREAD_DESCRIPTION", "Mark a pull request review thread as resolved.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_RESOLVE_PULL_REQUEST_REVIEW_THREAD_USER_TITLE", "Resolve pull request review thread"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("threadId",
+				mcp.Required(),
+				mcp.Description("The node ID of the review thread, as returned by list_pull_request_review_threads"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			threadID, err := RequiredParam[string](request, "threadId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			var mutation struct {
+				ResolveReviewThread struct {
+					Thread struct {
+						ID         githubv4.ID
+						IsResolved githubv4.Boolean
+					}
+				} `graphql:"resolveReviewThread(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, githubv4.ResolveReviewThreadInput{
+				ThreadID: githubv4.ID(threadID),
+			}, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to resolve pull request review thread", err), nil
+			}
+
+			thread := mutation.ResolveReviewThread.Thread
+			return MarshalledTextResult(map[string]any{
+				"id":         fmt.Sprint(thread.ID),
+				"isResolved": bool(thread.IsResolved),
+			}), nil
+		}
+}
+
+// UnresolvePullRequestReviewThread creates a tool to mark a pull request review thread as unresolved.
+func UnresolvePullRequestReviewThread(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("unresolve_pull_request_review_thread",
+			mcp.WithDescription(t("TOOL_UNRESOLVE_PULL_REQUEST_REVIEW_THREAD_DESCRIPTION", "Mark a resolved pull request review thread as unresolved.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UNRESOLVE_PULL_REQUEST_REVIEW_THREAD_USER_TITLE", "Unresolve pull request review thread"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("threadId",
+				mcp.Required(),
+				mcp.Description("The node ID of the review thread, as returned by list_pull_request_review_threads"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			threadID, err := RequiredParam[string](request, "threadId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			var mutation struct {
+				UnresolveReviewThread struct {
+					Thread struct {
+						ID         githubv4.ID
+						IsResolved githubv4.Boolean
+					}
+				} `graphql:"unresolveReviewThread(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, githubv4.UnresolveReviewThreadInput{
+				ThreadID: githubv4.ID(threadID),
+			}, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to unresolve pull request review thread", err), nil
+			}
+
+			thread := mutation.UnresolveReviewThread.Thread
+			return MarshalledTextResult(map[string]any{
+				"id":         fmt.Sprint(thread.ID),
+				"isResolved": bool(thread.IsResolved),
+			}), nil
+		}
+}
+
+// PullRequestMergeStatusFragment is the auto-merge and merge queue state of a pull request in the GraphQL API.
+type PullRequestMergeStatusFragment struct {
+	ID               githubv4.ID
+	Number           githubv4.Int
+	State            githubv4.PullRequestState
+	IsDraft          githubv4.Boolean
+	MergeStateStatus githubv4.MergeStateStatus
+	HeadRefOid       githubv4.GitObjectID
+	AutoMergeRequest *struct {
+		EnabledAt      githubv4.DateTime
+		MergeMethod    githubv4.PullRequestMergeMethod
+		CommitHeadline *githubv4.String
+		EnabledBy      struct {
+			Login githubv4.String
+		}
+	}
+	MergeQueueEntry *struct {
+		Position             githubv4.Int
+		State                githubv4.MergeQueueEntryState
+		EnqueuedAt           githubv4.DateTime
+		EstimatedTimeToMerge *githubv4.Int
+	}
+}
+
+// GetPullRequestMergeStatusQuery is the GraphQL query for the auto-merge and merge queue state of a pull request.
+type GetPullRequestMergeStatusQuery struct {
+	Repository struct {
+		PullRequest PullRequestMergeStatusFragment `graphql:"pullRequest(number: $prNum)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// PullRequestMergeStatus is the output type for the auto-merge and merge queue state of a pull request.
+type PullRequestMergeStatus struct {
+	Number           int               `json:"number"`
+	State            string            `json:"state"`
+	IsDraft          bool              `json:"isDraft"`
+	MergeStateStatus string            `json:"mergeStateStatus"`
+	HeadSHA          string            `json:"headSha"`
+	AutoMerge        *AutoMergeStatus  `json:"autoMerge"`
+	MergeQueue       *MergeQueueStatus `json:"mergeQueue"`
+}
+
+// AutoMergeStatus describes the auto-merge request of a pull request.
+type AutoMergeStatus struct {
+	EnabledAt      string `json:"enabledAt"`
+	EnabledBy      string `json:"enabledBy"`
+	MergeMethod    string `json:"mergeMethod"`
+	CommitHeadline string `json:"commitHeadline,omitempty"`
+}
+
+// MergeQueueStatus describes the merge queue entry of a pull request.
+type MergeQueueStatus struct {
+	Position                    int    `json:"position"`
+	State                       string `json:"state"`
+	EnqueuedAt                  string `json:"enqueuedAt"`
+	EstimatedSecondsUntilMerged *int   `json:"estimatedSecondsUntilMerged,omitempty"`
+}
+
+func convertToPullRequestMergeStatus(fragment PullRequestMergeStatusFragment) PullRequestMergeStatus {
+	status := PullRequestMergeStatus{
+		Number:           int(fragment.Number),
+		State:            string(fragment.State),
+		IsDraft:          bool(fragment.IsDraft),
+		MergeStateStatus: string(fragment.MergeStateStatus),
+		HeadSHA:          string(fragment.HeadRefOid),
+	}
+	if request := fragment.AutoMergeRequest; request != nil {
+		status.AutoMerge = &AutoMergeStatus{
+			EnabledAt:   request.EnabledAt.Format("2006-01-02T15:04:05Z"),
+			EnabledBy:   string(request.EnabledBy.Login),
+			MergeMethod: string(request.MergeMethod),
+		}
+		if request.CommitHeadline != nil {
+			status.AutoMerge.CommitHeadline = string(*request.CommitHeadline)
+		}
+	}
+	if entry := fragment.MergeQueueEntry; entry != nil {
+		status.MergeQueue = &MergeQueueStatus{
+			Position:   int(entry.Position),
+			State:      string(entry.State),
+			EnqueuedAt: entry.EnqueuedAt.Format("2006-01-02T15:04:05Z"),
+		}
+		if entry.EstimatedTimeToMerge != nil {
+			seconds := int(*entry.EstimatedTimeToMerge)
+			status.MergeQueue.EstimatedSecondsUntilMerged = &seconds
+		}
+	}
+	return status
+}
+
+// getPullRequestMergeStatus looks up the node ID and merge state of a pull request by its number.
+func getPullRequestMergeStatus(ctx context.Context, client *githubv4.Client, owner, repo string, pullNumber int) (*PullRequestMergeStatusFragment, *mcp.CallToolResult) {
+	var query GetPullRequestMergeStatusQuery
+	if err := client.Query(ctx, &query, map[string]any{
+		"owner": githubv4.String(owner),
+		"repo":  githubv4.String(repo),
+		"prNum": githubv4.Int(pullNumber), // #nosec G115 - pull request numbers are always small positive integers
+	}); err != nil {
+		return nil, ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get pull request", err)
+	}
+	return &query.Repository.PullRequest, nil
+}
+
+// pullRequestRefParams reads the owner, repo and pullNumber parameters shared by the merge tools.
+func pullRequestRefParams(request mcp.CallToolRequest) (string, string, int, error) {
+	owner, err := RequiredParam[string](request, "owner")
+	if err != nil {
+		return "", "", 0, err
+	}
+	repo, err := RequiredParam[string](request, "repo")
+	if err != nil {
+		return "", "", 0, err
+	}
+	pullNumber, err := RequiredInt(request, "pullNumber")
+	if err != nil {
+		return "", "", 0, err
+	}
+	return owner, repo, pullNumber, nil
+}
+
+// withPullRequestRef adds the owner, repo and pullNumber parameters to a tool.
+func withPullRequestRef() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner"),
+		)(tool)
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		)(tool)
+		mcp.WithNumber("pullNumber",
+			mcp.Required(),
+			mcp.Description("Pull request number"),
+		)(tool)
+	}
+}
+
+// GetPullRequestMergeStatus creates a tool to get the auto-merge and merge queue state of a pull request.
+func GetPullRequestMergeStatus(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pull_request_merge_status",
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_MERGE_STATUS_DESCRIPTION", "Get whether auto-merge is enabled on a pull request and, if it is in a merge queue, its position and state in the queue.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PULL_REQUEST_MERGE_STATUS_USER_TITLE", "Get pull request auto-merge and merge queue status"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			withPullRequestRef(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, pullNumber, err := pullRequestRefParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			pr, result := getPullRequestMergeStatus(ctx, client, owner, repo, pullNumber)
+			if result != nil {
+				return result, nil
+			}
+			return MarshalledTextResult(convertToPullRequestMergeStatus(*pr)), nil
+		}
+}
+
+// EnablePullRequestAutoMerge creates a tool to merge a pull request automatically once its requirements are met.
+func EnablePullRequestAutoMerge(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("enable_pull_request_auto_merge",
+			mcp.WithDescription(t("TOOL_ENABLE_PULL_REQUEST_AUTO_MERGE_DESCRIPTION", "Enable auto-merge on a pull request, so that it is merged, or added to the merge queue, as soon as all required reviews and checks pass. Fails if the pull request can already be merged; use merge_pull_request in that case.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ENABLE_PULL_REQUEST_AUTO_MERGE_USER_TITLE", "Enable pull request auto-merge"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			withPullRequestRef(),
+			mcp.WithString("merge_method",
+				mcp.Description("Merge method. Ignored when the base branch uses a merge queue"),
+				mcp.Enum("merge", "squash", "rebase"),
+			),
+			mcp.WithString("commit_title",
+				mcp.Description("Title for merge commit. Ignored when the base branch uses a merge queue"),
+			),
+			mcp.WithString("commit_message",
+				mcp.Description("Extra detail for merge commit. Ignored when the base branch uses a merge queue"),
+			),
+			mcp.WithString("expected_head_sha",
+				mcp.Description("Only enable auto-merge if the head of the pull request is still at this commit SHA"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, pullNumber, err := pullRequestRefParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			mergeMethod, err := OptionalParam[string](request, "merge_method")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commitTitle, err := OptionalParam[string](request, "commit_title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commitMessage, err := OptionalParam[string](request, "commit_message")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			expectedHeadSHA, err := OptionalParam[string](request, "expected_head_sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			pr, result := getPullRequestMergeStatus(ctx, client, owner, repo, pullNumber)
+			if result != nil {
+				return result, nil
+			}
+
+			input := githubv4.EnablePullRequestAutoMergeInput{
+				PullRequestID:   pr.ID,
+				MergeMethod:     newGQLStringlike[githubv4.PullRequestMergeMethod](strings.ToUpper(mergeMethod)),
+				CommitHeadline:  newGQLStringlike[githubv4.String](commitTitle),
+				CommitBody:      newGQLStringlike[githubv4.String](commitMessage),
+				ExpectedHeadOid: newGQLStringlike[githubv4.GitObjectID](expectedHeadSHA),
+			}
+
+			var mutation struct {
+				EnablePullRequestAutoMerge struct {
+					PullRequest PullRequestMergeStatusFragment
+				} `graphql:"enablePullRequestAutoMerge(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to enable auto-merge", err), nil
+			}
+
+			return MarshalledTextResult(convertToPullRequestMergeStatus(mutation.EnablePullRequestAutoMerge.PullRequest)), nil
+		}
+}
+
+// DisablePullRequestAutoMerge creates a tool to cancel auto-merge on a pull request.
+func DisablePullRequestAutoMerge(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("disable_pull_request_auto_merge",
+			mcp.WithDescription(t("TOOL_DISABLE_PULL_REQUEST_AUTO_MERGE_DESCRIPTION", "Disable auto-merge on a pull request.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_DISABLE_PULL_REQUEST_AUTO_MERGE_USER_TITLE", "Disable pull request auto-merge"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			withPullRequestRef(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, pullNumber, err := pullRequestRefParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			pr, result := getPullRequestMergeStatus(ctx, client, owner, repo, pullNumber)
+			if result != nil {
+				return result, nil
+			}
+
+			var mutation struct {
+				DisablePullRequestAutoMerge struct {
+					PullRequest PullRequestMergeStatusFragment
+				} `graphql:"disablePullRequestAutoMerge(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, githubv4.DisablePullRequestAutoMergeInput{
+				PullRequestID: pr.ID,
+			}, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to disable auto-merge", err), nil
+			}
+
+			return MarshalledTextResult(convertToPullRequestMergeStatus(mutation.DisablePullRequestAutoMerge.PullRequest)), nil
+		}
+}
+
+// AddPullRequestToMergeQueue creates a tool to add a pull request to the merge queue of its base branch.
+func AddPullRequestToMergeQueue(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("add_pull_request_to_merge_queue",
+			mcp.WithDescription(t("TOOL_ADD_PULL_REQUEST_TO_MERGE_QUEUE_DESCRIPTION", "Add a pull request to the merge queue of its base branch. The base branch must require a merge queue.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ADD_PULL_REQUEST_TO_MERGE_QUEUE_USER_TITLE", "Add pull request to merge queue"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			withPullRequestRef(),
+			mcp.WithBoolean("jump",
+				mcp.Description("Add the pull request to the front of the queue"),
+			),
+			mcp.WithString("expected_head_sha",
+				mcp.Description("Only add the pull request if its head is still at this commit SHA"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, pullNumber, err := pullRequestRefParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			jump, err := OptionalParam[bool](request, "jump")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			expectedHeadSHA, err := OptionalParam[string](request, "expected_head_sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			pr, result := getPullRequestMergeStatus(ctx, client, owner, repo, pullNumber)
+			if result != nil {
+				return result, nil
+			}
+
+			input := githubv4.EnqueuePullRequestInput{
+				PullRequestID:   pr.ID,
+				ExpectedHeadOid: newGQLStringlike[githubv4.GitObjectID](expectedHeadSHA),
+			}
+			if jump {
+				input.Jump = githubv4.NewBoolean(true)
+			}
+
+			var mutation struct {
+				EnqueuePullRequest struct {
+					MergeQueueEntry struct {
+						PullRequest PullRequestMergeStatusFragment
+					}
+				} `graphql:"enqueuePullRequest(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to add pull request to merge queue", err), nil
+			}
+
+			return MarshalledTextResult(convertToPullRequestMergeStatus(mutation.EnqueuePullRequest.MergeQueueEntry.PullRequest)), nil
+		}
+}
+
+// RemovePullRequestFromMergeQueue creates a tool to take a pull request out of the merge queue.
+func RemovePullRequestFromMergeQueue(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_pull_request_from_merge_queue",
+			mcp.WithDescription(t("TOOL_REMOVE_PULL_REQUEST_FROM_MERGE_QUEUE_DESCRIPTION", "Remove a pull request from the merge queue.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_REMOVE_PULL_REQUEST_FROM_MERGE_QUEUE_USER_TITLE", "Remove pull request from merge queue"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			withPullRequestRef(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, pullNumber, err := pullRequestRefParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			pr, result := getPullRequestMergeStatus(ctx, client, owner, repo, pullNumber)
+			if result != nil {
+				return result, nil
+			}
+			if pr.MergeQueueEntry == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("pull request #%d is not in a merge queue", pullNumber)), nil
+			}
+
+			var mutation struct {
+				DequeuePullRequest struct {
+					MergeQueueEntry struct {
+						PullRequest PullRequestMergeStatusFragment
+					}
+				} `graphql:"dequeuePullRequest(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, githubv4.DequeuePullRequestInput{
+				ID: pr.ID,
+			}, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to remove pull request from merge queue", err), nil
+			}
+
+			return MarshalledTextResult(convertToPullRequestMergeStatus(mutation.DequeuePullRequest.MergeQueueEntry.PullRequest)), nil
+		}
+}
+
 // newGQLString like takes something that approximates a string (of which there are many types in shurcooL/githubv4)
 // and constructs a pointer to it, or nil if the string is empty. This is extremely useful because when we parse
 // params from the MCP request, we need to convert them to types that are pointers of type def strings and it's
@@ -411,6 +411,7 @@ func Test_UpdatePullRequest_Draft(t *testing.T) {
 		requestArgs    map[string]interface{}
 		expectError    bool
 		expectedPR     *github.PullRequest
+		expectedDraft  bool
 		expectedErrMsg string
 	}{
 		{
@@ -422,6 +423,7 @@ func Test_UpdatePullRequest_Draft(t *testing.T) {
 							PullRequest struct {
 								ID      githubv4.ID
 								IsDraft githubv4.Boolean
+								State   githubv4.PullRequestState
 							} `graphql:"pullRequest(number: $prNum)"`
 						} `graphql:"repository(owner: $owner, name: $repo)"`
 					}{},
@@ -435,6 +437,7 @@ func Test_UpdatePullRequest_Draft(t *testing.T) {
 							"pullRequest": map[string]any{
 								"id":      "PR_kwDOA0xdyM50BPaO",
 								"isDraft": true, // Current state is draft
+								"state":   "OPEN",
 							},
 						},
 					}),
@@ -480,6 +483,7 @@ func Test_UpdatePullRequest_Draft(t *testing.T) {
 							PullRequest struct {
 								ID      githubv4.ID
 								IsDraft githubv4.Boolean
+								State   githubv4.PullRequestState
 							} `graphql:"pullRequest(number: $prNum)"`
 						} `graphql:"repository(owner: $owner, name: $repo)"`
 					}{},
@@ -492,7 +496,8 @@ func Test_UpdatePullRequest_Draft(t *testing.T) {
 						"repository": map[string]any{
 							"pullRequest": map[string]any{
 								"id":      "PR_kwDOA0xdyM50BPaO",
-								"isDraft": false, // Current state is draft
+								"isDraft": false, // Current state is ready for review
+								"state":   "OPEN",
 							},
 						},
 					}),
@@ -526,8 +531,125 @@ func Test_UpdatePullRequest_Draft(t *testing.T) {
 				"pullNumber": float64(42),
 				"draft":      true,
 			},
-			expectError: false,
-			expectedPR:  mockUpdatedPR,
+			expectError:   false,
+			expectedPR:    mockUpdatedPR,
+			expectedDraft: true,
+		},
+		{
+			name: "already in requested state skips mutation",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					struct {
+						Repository struct {
+							PullRequest struct {
+								ID      githubv4.ID
+								IsDraft githubv4.Boolean
+								State   githubv4.PullRequestState
+							} `graphql:"pullRequest(number: $prNum)"`
+						} `graphql:"repository(owner: $owner, name: $repo)"`
+					}{},
+					map[string]any{
+						"owner": githubv4.String("owner"),
+						"repo":  githubv4.String("repo"),
+						"prNum": githubv4.Int(42),
+					},
+					githubv4mock.DataResponse(map[string]any{
+						"repository": map[string]any{
+							"pullRequest": map[string]any{
+								"id":      "PR_kwDOA0xdyM50BPaO",
+								"isDraft": true,
+								"state":   "OPEN",
+							},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"draft":      true,
+			},
+			expectedPR:    mockUpdatedPR,
+			expectedDraft: true,
+		},
+		{
+			name: "closed pull request cannot change draft state",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					struct {
+						Repository struct {
+							PullRequest struct {
+								ID      githubv4.ID
+								IsDraft githubv4.Boolean
+								State   githubv4.PullRequestState
+							} `graphql:"pullRequest(number: $prNum)"`
+						} `graphql:"repository(owner: $owner, name: $repo)"`
+					}{},
+					map[string]any{
+						"owner": githubv4.String("owner"),
+						"repo":  githubv4.String("repo"),
+						"prNum": githubv4.Int(42),
+					},
+					githubv4mock.DataResponse(map[string]any{
+						"repository": map[string]any{
+							"pullRequest": map[string]any{
+								"id":      "PR_kwDOA0xdyM50BPaO",
+								"isDraft": false,
+								"state":   "CLOSED",
+							},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"draft":      true,
+			},
+			expectError:    true,
+			expectedErrMsg: "cannot change the draft state of a closed pull request",
+		},
+		{
+			name: "draft change is rejected before closing the pull request",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					struct {
+						Repository struct {
+							PullRequest struct {
+								ID      githubv4.ID
+								IsDraft githubv4.Boolean
+								State   githubv4.PullRequestState
+							} `graphql:"pullRequest(number: $prNum)"`
+						} `graphql:"repository(owner: $owner, name: $repo)"`
+					}{},
+					map[string]any{
+						"owner": githubv4.String("owner"),
+						"repo":  githubv4.String("repo"),
+						"prNum": githubv4.Int(42),
+					},
+					githubv4mock.DataResponse(map[string]any{
+						"repository": map[string]any{
+							"pullRequest": map[string]any{
+								"id":      "PR_kwDOA0xdyM50BPaO",
+								"isDraft": false,
+								"state":   "OPEN",
+							},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"state":      "closed",
+				"draft":      true,
+				"reviewers":  []any{"octocat"},
+			},
+			expectError:    true,
+			expectedErrMsg: "cannot change the draft state of a pull request while closing it",
 		},
 	}
 
@@ -539,6 +661,12 @@ func Test_UpdatePullRequest_Draft(t *testing.T) {
 					mock.GetReposPullsByOwnerByRepoByPullNumber,
 					mockUpdatedPR,
 				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposPullsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+						t.Error("pull request was edited although the draft change was rejected")
+					}),
+				),
 			))
 			gqlClient := githubv4.NewClient(tc.mockedClient)
 
@@ -564,10 +692,12 @@ func Test_UpdatePullRequest_Draft(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the minimal result
-			var updateResp MinimalResponse
+			var updateResp MinimalPullRequestUpdateResponse
 			err = json.Unmarshal([]byte(textContent.Text), &updateResp)
 			require.NoError(t, err)
 			assert.Equal(t, tc.expectedPR.GetHTMLURL(), updateResp.URL)
+			assert.Equal(t, "open", updateResp.State)
+			assert.Equal(t, tc.expectedDraft, updateResp.Draft)
 		})
 	}
 }
@@ -2910,3 +3040,1193 @@ func getLatestPendingReviewQuery(p getLatestPendingReviewQueryParams) githubv4mo
 		),
 	)
 }
+
+func TestListPullRequestReviewThreads(t *testing.T) {
+	t.Parallel()
+
+	// Verify tool definition once
+	mockClient := githubv4.NewClient(nil)
+	tool, _ := ListPullRequestReviewThreads(stubGetGQLClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_pull_request_review_threads", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "commentsPerThread")
+	assert.Contains(t, tool.InputSchema.Properties, "after")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	vars := map[string]any{
+		"owner":         githubv4.String("owner"),
+		"repo":          githubv4.String("repo"),
+		"prNum":         githubv4.Int(42),
+		"first":         githubv4.Int(30),
+		"commentsFirst": githubv4.Int(30),
+		"after":         (*githubv4.String)(nil),
+	}
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]any
+		expectToolError    bool
+		expectedToolErrMsg string
+		expectedThreads    []ReviewThread
+	}{
+		{
+			name: "successful listing",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					ListReviewThreadsQuery{},
+					vars,
+					githubv4mock.DataResponse(map[string]any{
+						"repository": map[string]any{
+							"pullRequest": map[string]any{
+								"reviewThreads": map[string]any{
+									"nodes": []any{
+										map[string]any{
+											"id":           "PRRT_1",
+											"isResolved":   false,
+											"isOutdated":   true,
+											"isCollapsed":  false,
+											"path":         "main.go",
+											"line":         nil,
+											"startLine":    nil,
+											"originalLine": 12,
+											"diffSide":     "RIGHT",
+											"subjectType":  "LINE",
+											"resolvedBy":   nil,
+											"comments": map[string]any{
+												"totalCount": 1,
+												"nodes": []any{
+													map[string]any{
+														"id":         "PRRC_1",
+														"databaseId": 101,
+														"body":       "Please rename this",
+														"author":     map[string]any{"login": "reviewer"},
+														"createdAt":  "2025-01-01T10:00:00Z",
+														"url":        "https://github.com/owner/repo/pull/42#discussion_r101",
+													},
+												},
+											},
+										},
+										map[string]any{
+											"id":           "PRRT_2",
+											"isResolved":   true,
+											"isOutdated":   false,
+											"isCollapsed":  true,
+											"path":         "README.md",
+											"line":         3,
+											"startLine":    1,
+											"originalLine": 3,
+											"diffSide":     "RIGHT",
+											"subjectType":  "LINE",
+											"resolvedBy":   map[string]any{"login": "author"},
+											"comments": map[string]any{
+												"totalCount": 0,
+												"nodes":      []any{},
+											},
+										},
+									},
+									"pageInfo": map[string]any{
+										"hasNextPage":     true,
+										"hasPreviousPage": false,
+										"startCursor":     "start",
+										"endCursor":       "end",
+									},
+									"totalCount": 5,
+								},
+							},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedThreads: []ReviewThread{
+				{
+					ID:            "PRRT_1",
+					IsOutdated:    true,
+					Path:          "main.go",
+					OriginalLine:  github.Ptr(12),
+					DiffSide:      "RIGHT",
+					SubjectType:   "LINE",
+					CommentsCount: 1,
+					Comments: []ReviewThreadComment{
+						{
+							ID:         "PRRC_1",
+							DatabaseID: 101,
+							Author:     "reviewer",
+							Body:       "Please rename this",
+							CreatedAt:  "2025-01-01T10:00:00Z",
+							URL:        "https://github.com/owner/repo/pull/42#discussion_r101",
+						},
+					},
+				},
+				{
+					ID:            "PRRT_2",
+					IsResolved:    true,
+					IsCollapsed:   true,
+					Path:          "README.md",
+					Line:          github.Ptr(3),
+					StartLine:     github.Ptr(1),
+					OriginalLine:  github.Ptr(3),
+					DiffSide:      "RIGHT",
+					SubjectType:   "LINE",
+					ResolvedBy:    "author",
+					CommentsCount: 0,
+					Comments:      []ReviewThreadComment{},
+				},
+			},
+		},
+		{
+			name: "pull request not found",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					ListReviewThreadsQuery{},
+					vars,
+					githubv4mock.ErrorResponse("Could not resolve to a PullRequest with the number of 42."),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectToolError:    true,
+			expectedToolErrMsg: "failed to list pull request review threads",
+		},
+		{
+			name:         "invalid comments per thread",
+			mockedClient: githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":             "owner",
+				"repo":              "repo",
+				"pullNumber":        float64(42),
+				"commentsPerThread": float64(500),
+			},
+			expectToolError:    true,
+			expectedToolErrMsg: "commentsPerThread must be between 1 and 100",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := githubv4.NewClient(tc.mockedClient)
+			_, handler := ListPullRequestReviewThreads(stubGetGQLClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectToolError {
+				require.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedToolErrMsg)
+				return
+			}
+
+			var returned struct {
+				Threads  []ReviewThread `json:"threads"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+				TotalCount int `json:"totalCount"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedThreads, returned.Threads)
+			assert.True(t, returned.PageInfo.HasNextPage)
+			assert.Equal(t, "end", returned.PageInfo.EndCursor)
+			assert.Equal(t, 5, returned.TotalCount)
+		})
+	}
+}
+
+func TestReplyToPullRequestReviewThread(t *testing.T) {
+	t.Parallel()
+
+	// Verify tool definition once
+	mockClient := githubv4.NewClient(nil)
+	tool, _ := ReplyToPullRequestReviewThread(stubGetGQLClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "reply_to_pull_request_review_thread", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"threadId", "body"})
+
+	mutation := struct {
+		AddPullRequestReviewThreadReply struct {
+			Comment struct {
+				ID  githubv4.ID
+				URL githubv4.URI
+			}
+		} `graphql:"addPullRequestReviewThreadReply(input: $input)"`
+	}{}
+	input := githubv4.AddPullRequestReviewThreadReplyInput{
+		PullRequestReviewThreadID: githubv4.ID("PRRT_1"),
+		Body:                      githubv4.String("Done, thanks!"),
+	}
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		expectToolError    bool
+		expectedToolErrMsg string
+	}{
+		{
+			name: "successful reply",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewMutationMatcher(mutation, input, nil,
+					githubv4mock.DataResponse(map[string]any{
+						"addPullRequestReviewThreadReply": map[string]any{
+							"comment": map[string]any{
+								"id":  "PRRC_2",
+								"url": "https://github.com/owner/repo/pull/42#discussion_r102",
+							},
+						},
+					}),
+				),
+			),
+		},
+		{
+			name: "reply fails",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewMutationMatcher(mutation, input, nil,
+					githubv4mock.ErrorResponse("Could not resolve to a node with the global id of 'PRRT_1'"),
+				),
+			),
+			expectToolError:    true,
+			expectedToolErrMsg: "failed to reply to pull request review thread",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := githubv4.NewClient(tc.mockedClient)
+			_, handler := ReplyToPullRequestReviewThread(stubGetGQLClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"threadId": "PRRT_1",
+				"body":     "Done, thanks!",
+			}))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectToolError {
+				require.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedToolErrMsg)
+				return
+			}
+
+			var returned MinimalResponse
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, MinimalResponse{ID: "PRRC_2", URL: "https://github.com/owner/repo/pull/42#discussion_r102"}, returned)
+		})
+	}
+}
+
+func TestResolveAndUnresolvePullRequestReviewThread(t *testing.T) {
+	t.Parallel()
+
+	resolveTool, _ := ResolvePullRequestReviewThread(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(resolveTool.Name, resolveTool))
+	assert.Equal(t, "resolve_pull_request_review_thread", resolveTool.Name)
+	assert.ElementsMatch(t, resolveTool.InputSchema.Required, []string{"threadId"})
+
+	unresolveTool, _ := UnresolvePullRequestReviewThread(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(unresolveTool.Name, unresolveTool))
+	assert.Equal(t, "unresolve_pull_request_review_thread", unresolveTool.Name)
+	assert.ElementsMatch(t, unresolveTool.InputSchema.Required, []string{"threadId"})
+
+	t.Run("resolve", func(t *testing.T) {
+		t.Parallel()
+
+		mockedClient := githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewMutationMatcher(
+				struct {
+					ResolveReviewThread struct {
+						Thread struct {
+							ID         githubv4.ID
+							IsResolved githubv4.Boolean
+						}
+					} `graphql:"resolveReviewThread(input: $input)"`
+				}{},
+				githubv4.ResolveReviewThreadInput{ThreadID: githubv4.ID("PRRT_1")},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"resolveReviewThread": map[string]any{
+						"thread": map[string]any{"id": "PRRT_1", "isResolved": true},
+					},
+				}),
+			),
+		)
+		_, handler := ResolvePullRequestReviewThread(stubGetGQLClientFn(githubv4.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{"threadId": "PRRT_1"}))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		assert.JSONEq(t, `{"id":"PRRT_1","isResolved":true}`, getTextResult(t, result).Text)
+	})
+
+	t.Run("unresolve", func(t *testing.T) {
+		t.Parallel()
+
+		mockedClient := githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewMutationMatcher(
+				struct {
+					UnresolveReviewThread struct {
+						Thread struct {
+							ID         githubv4.ID
+							IsResolved githubv4.Boolean
+						}
+					} `graphql:"unresolveReviewThread(input: $input)"`
+				}{},
+				githubv4.UnresolveReviewThreadInput{ThreadID: githubv4.ID("PRRT_1")},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"unresolveReviewThread": map[string]any{
+						"thread": map[string]any{"id": "PRRT_1", "isResolved": false},
+					},
+				}),
+			),
+		)
+		_, handler := UnresolvePullRequestReviewThread(stubGetGQLClientFn(githubv4.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{"threadId": "PRRT_1"}))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		assert.JSONEq(t, `{"id":"PRRT_1","isResolved":false}`, getTextResult(t, result).Text)
+	})
+
+	t.Run("missing thread ID", func(t *testing.T) {
+		t.Parallel()
+
+		_, handler := ResolvePullRequestReviewThread(stubGetGQLClientFn(githubv4.NewClient(githubv4mock.NewMockedHTTPClient())), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "missing required parameter: threadId")
+	})
+}
+
+func Test_ListPullRequestCommits(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListPullRequestCommits(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_pull_request_commits", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	mockCommits := []*github.RepositoryCommit{
+		{
+			SHA: github.Ptr("abc123"),
+			Commit: &github.Commit{
+				Message: github.Ptr("First commit"),
+				Author: &github.CommitAuthor{
+					Name:  github.Ptr("Octo Cat"),
+					Email: github.Ptr("octocat@github.com"),
+				},
+			},
+			Author: &github.User{Login: github.Ptr("octocat")},
+		},
+		{
+			SHA:    github.Ptr("def456"),
+			Commit: &github.Commit{Message: github.Ptr("Second commit")},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful commits fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsCommitsByOwnerByRepoByPullNumber,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockCommits),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"page":       float64(2),
+				"perPage":    float64(10),
+			},
+		},
+		{
+			name: "commits fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsCommitsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list pull request commits",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListPullRequestCommits(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returnedCommits []MinimalCommit
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returnedCommits))
+			require.Len(t, returnedCommits, 2)
+			assert.Equal(t, "abc123", returnedCommits[0].SHA)
+			assert.Equal(t, "First commit", returnedCommits[0].Commit.Message)
+			assert.Equal(t, "octocat", returnedCommits[0].Author.Login)
+			assert.Equal(t, "def456", returnedCommits[1].SHA)
+		})
+	}
+}
+
+func Test_GetPullRequestTimeline(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPullRequestTimeline(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_pull_request_timeline", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockEvents := []*github.Timeline{
+		{
+			ID:        github.Ptr(int64(1)),
+			Event:     github.Ptr("labeled"),
+			Actor:     &github.User{Login: github.Ptr("octocat")},
+			Label:     &github.Label{Name: github.Ptr("bug")},
+			CreatedAt: &github.Timestamp{Time: createdAt},
+		},
+		{
+			Event:   github.Ptr("committed"),
+			SHA:     github.Ptr("abc123"),
+			Message: github.Ptr("Fix the bug"),
+			Author: &github.CommitAuthor{
+				Name: github.Ptr("Octo Cat"),
+				Date: &github.Timestamp{Time: createdAt},
+			},
+		},
+		{
+			ID:          github.Ptr(int64(2)),
+			Event:       github.Ptr("reviewed"),
+			User:        &github.User{Login: github.Ptr("reviewer")},
+			State:       github.Ptr("approved"),
+			SubmittedAt: &github.Timestamp{Time: createdAt},
+		},
+		{
+			Event: github.Ptr("cross-referenced"),
+			Source: &github.Source{
+				Issue: &github.Issue{
+					Number:  github.Ptr(7),
+					Title:   github.Ptr("Related issue"),
+					HTMLURL: github.Ptr("https://github.com/owner/repo/issues/7"),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful timeline fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesTimelineByOwnerByRepoByIssueNumber,
+					expectPath(t, "/repos/owner/repo/issues/42/timeline").andThen(
+						mockResponse(t, http.StatusOK, mockEvents),
+					),
+				),
+			),
+		},
+		{
+			name: "timeline fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesTimelineByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get pull request timeline",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPullRequestTimeline(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returnedEvents []MinimalTimelineEvent
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returnedEvents))
+			require.Len(t, returnedEvents, 4)
+
+			assert.Equal(t, "labeled", returnedEvents[0].Event)
+			assert.Equal(t, "octocat", returnedEvents[0].Actor)
+			assert.Equal(t, "bug", returnedEvents[0].Label)
+			assert.Equal(t, "2025-01-02T03:04:05Z", returnedEvents[0].CreatedAt)
+
+			assert.Equal(t, "abc123", returnedEvents[1].SHA)
+			require.NotNil(t, returnedEvents[1].Author)
+			assert.Equal(t, "Octo Cat", returnedEvents[1].Author.Name)
+
+			assert.Equal(t, "reviewer", returnedEvents[2].Actor)
+			assert.Equal(t, "approved", returnedEvents[2].State)
+			assert.Equal(t, "2025-01-02T03:04:05Z", returnedEvents[2].CreatedAt)
+
+			require.NotNil(t, returnedEvents[3].Source)
+			assert.Equal(t, 7, returnedEvents[3].Source.Number)
+			assert.False(t, returnedEvents[3].Source.PullRequest)
+		})
+	}
+}
+
+func Test_GetPullRequestMergeability(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPullRequestMergeability(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_pull_request_mergeability", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	readyPR := &github.PullRequest{
+		Number:         github.Ptr(42),
+		State:          github.Ptr("open"),
+		Mergeable:      github.Ptr(true),
+		MergeableState: github.Ptr("clean"),
+		Base:           &github.PullRequestBranch{Ref: github.Ptr("main")},
+		Head:           &github.PullRequestBranch{SHA: github.Ptr("abc123")},
+	}
+	releasePR := &github.PullRequest{
+		Number:         github.Ptr(42),
+		State:          github.Ptr("open"),
+		Mergeable:      github.Ptr(true),
+		MergeableState: github.Ptr("clean"),
+		Base:           &github.PullRequestBranch{Ref: github.Ptr("release/1.0")},
+		Head:           &github.PullRequestBranch{SHA: github.Ptr("abc123")},
+	}
+	blockedPR := &github.PullRequest{
+		Number:         github.Ptr(42),
+		State:          github.Ptr("open"),
+		Draft:          github.Ptr(true),
+		Mergeable:      github.Ptr(false),
+		MergeableState: github.Ptr("dirty"),
+		Base:           &github.PullRequestBranch{Ref: github.Ptr("main")},
+		Head:           &github.PullRequestBranch{SHA: github.Ptr("abc123")},
+	}
+
+	protection := &github.Protection{
+		RequiredPullRequestReviews: &github.PullRequestReviewsEnforcement{
+			RequiredApprovingReviewCount: 1,
+		},
+		RequiredStatusChecks: &github.RequiredStatusChecks{
+			Contexts: &[]string{"build"},
+		},
+	}
+	rules := []map[string]any{
+		{
+			"type": "pull_request",
+			"parameters": map[string]any{
+				"required_approving_review_count":   2,
+				"dismiss_stale_reviews_on_push":     false,
+				"require_code_owner_review":         true,
+				"require_last_push_approval":        false,
+				"required_review_thread_resolution": false,
+			},
+		},
+		{
+			"type": "required_status_checks",
+			"parameters": map[string]any{
+				"strict_required_status_checks_policy": false,
+				"required_status_checks": []map[string]any{
+					{"context": "build"},
+					{"context": "lint"},
+				},
+			},
+		},
+	}
+	reviews := []*github.PullRequestReview{
+		{User: &github.User{Login: github.Ptr("alice")}, State: github.Ptr("CHANGES_REQUESTED")},
+		{User: &github.User{Login: github.Ptr("alice")}, State: github.Ptr("APPROVED")},
+		{User: &github.User{Login: github.Ptr("bob")}, State: github.Ptr("APPROVED")},
+		{User: &github.User{Login: github.Ptr("bob")}, State: github.Ptr("COMMENTED")},
+		{User: &github.User{Login: github.Ptr("carol")}, State: github.Ptr("CHANGES_REQUESTED")},
+	}
+	checkRuns := &github.ListCheckRunsResults{
+		Total: github.Ptr(2),
+		CheckRuns: []*github.CheckRun{
+			{Name: github.Ptr("build"), Status: github.Ptr("completed"), Conclusion: github.Ptr("success")},
+			{Name: github.Ptr("lint"), Status: github.Ptr("completed"), Conclusion: github.Ptr("failure")},
+		},
+	}
+	passingCheckRuns := &github.ListCheckRunsResults{
+		Total: github.Ptr(1),
+		CheckRuns: []*github.CheckRun{
+			{Name: github.Ptr("build"), Status: github.Ptr("completed"), Conclusion: github.Ptr("success")},
+		},
+	}
+	combinedStatus := &github.CombinedStatus{State: github.Ptr("success")}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		expectError      bool
+		expectedErrMsg   string
+		expectedState    string
+		expectedBlockers []string
+		expectedNotes    []string
+		expectedChecks   []RequiredCheck
+	}{
+		{
+			name: "ready to merge without branch protection",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, readyPR),
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, `{"message": "Branch not protected"}`),
+				),
+				mock.WithRequestMatch(mock.GetReposRulesBranchesByOwnerByRepoByBranch, []map[string]any{}),
+				mock.WithRequestMatch(mock.GetReposPullsReviewsByOwnerByRepoByPullNumber, []*github.PullRequestReview{}),
+				mock.WithRequestMatch(mock.GetReposCommitsCheckRunsByOwnerByRepoByRef, passingCheckRuns),
+				mock.WithRequestMatch(mock.GetReposCommitsStatusByOwnerByRepoByRef, combinedStatus),
+			),
+			expectedState:    "ready",
+			expectedBlockers: []string{},
+			expectedChecks:   []RequiredCheck{},
+		},
+		{
+			name: "blocked by protection and rulesets",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, blockedPR),
+				mock.WithRequestMatch(mock.GetReposBranchesProtectionByOwnerByRepoByBranch, protection),
+				mock.WithRequestMatch(mock.GetReposRulesBranchesByOwnerByRepoByBranch, rules),
+				mock.WithRequestMatch(mock.GetReposPullsReviewsByOwnerByRepoByPullNumber, reviews),
+				mock.WithRequestMatch(mock.GetReposCommitsCheckRunsByOwnerByRepoByRef, checkRuns),
+				mock.WithRequestMatch(mock.GetReposCommitsStatusByOwnerByRepoByRef, combinedStatus),
+			),
+			expectedState: "blocked",
+			expectedBlockers: []string{
+				"pull request is a draft; mark it ready for review",
+				"pull request has merge conflicts with the base branch",
+				"changes requested by carol",
+				`required check "lint" is failing`,
+			},
+			expectedChecks: []RequiredCheck{
+				{Name: "build", State: "passing"},
+				{Name: "lint", State: "failing"},
+			},
+		},
+		{
+			name: "unreadable protection is reported as a note",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, readyPR),
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusForbidden, `{"message": "Resource not accessible by integration"}`),
+				),
+				mock.WithRequestMatch(mock.GetReposRulesBranchesByOwnerByRepoByBranch, rules[1:]),
+				mock.WithRequestMatch(mock.GetReposPullsReviewsByOwnerByRepoByPullNumber, []*github.PullRequestReview{}),
+				mock.WithRequestMatch(mock.GetReposCommitsCheckRunsByOwnerByRepoByRef, passingCheckRuns),
+				mock.WithRequestMatch(mock.GetReposCommitsStatusByOwnerByRepoByRef, combinedStatus),
+			),
+			expectedState: "blocked",
+			expectedBlockers: []string{
+				`required check "lint" is missing`,
+			},
+			expectedNotes: []string{
+				"branch protection could not be read with this token; only rulesets are taken into account",
+			},
+			expectedChecks: []RequiredCheck{
+				{Name: "build", State: "passing"},
+				{Name: "lint", State: "missing"},
+			},
+		},
+		{
+			name: "latest review on a later page replaces an earlier verdict",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, readyPR),
+				mock.WithRequestMatch(mock.GetReposBranchesProtectionByOwnerByRepoByBranch, protection),
+				mock.WithRequestMatch(mock.GetReposRulesBranchesByOwnerByRepoByBranch, []map[string]any{}),
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.URL.Query().Get("page") == "2" {
+							mockResponse(t, http.StatusOK, reviews[1:2])(w, r)
+							return
+						}
+						w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/pulls/42/reviews?page=2>; rel="next"`)
+						mockResponse(t, http.StatusOK, reviews[:1])(w, r)
+					}),
+				),
+				mock.WithRequestMatch(mock.GetReposCommitsCheckRunsByOwnerByRepoByRef, passingCheckRuns),
+				mock.WithRequestMatch(mock.GetReposCommitsStatusByOwnerByRepoByRef, combinedStatus),
+			),
+			expectedState:    "ready",
+			expectedBlockers: []string{},
+			expectedChecks: []RequiredCheck{
+				{Name: "build", State: "passing"},
+			},
+		},
+		{
+			name: "rulesets of a base branch with a slash",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, releasePR),
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/repos/{owner}/{repo}/branches/{branch:.+}/protection", Method: "GET"},
+					mockResponse(t, http.StatusNotFound, `{"message": "Branch not protected"}`),
+				),
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/repos/{owner}/{repo}/rules/branches/{branch:.+}", Method: "GET"},
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.URL.EscapedPath() != "/repos/owner/repo/rules/branches/release%2F1.0" {
+							mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`)(w, r)
+							return
+						}
+						mockResponse(t, http.StatusOK, rules[1:])(w, r)
+					}),
+				),
+				mock.WithRequestMatch(mock.GetReposPullsReviewsByOwnerByRepoByPullNumber, []*github.PullRequestReview{}),
+				mock.WithRequestMatch(mock.GetReposCommitsCheckRunsByOwnerByRepoByRef, passingCheckRuns),
+				mock.WithRequestMatch(mock.GetReposCommitsStatusByOwnerByRepoByRef, combinedStatus),
+			),
+			expectedState: "blocked",
+			expectedBlockers: []string{
+				`required check "lint" is missing`,
+			},
+			expectedChecks: []RequiredCheck{
+				{Name: "build", State: "passing"},
+				{Name: "lint", State: "missing"},
+			},
+		},
+		{
+			name: "pull request fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get pull request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPullRequestMergeability(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var report PullRequestMergeability
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &report))
+			assert.Equal(t, tc.expectedState, report.State)
+			assert.Equal(t, tc.expectedState == "ready", report.CanMerge)
+			assert.Equal(t, tc.expectedBlockers, report.Blockers)
+			assert.Equal(t, tc.expectedNotes, report.Notes)
+			assert.Equal(t, tc.expectedChecks, report.RequiredChecks)
+		})
+	}
+}
+
+func mergeStatusResponse(autoMerge, mergeQueueEntry map[string]any) map[string]any {
+	pr := map[string]any{
+		"id":               "PR_1",
+		"number":           42,
+		"state":            "OPEN",
+		"isDraft":          false,
+		"mergeStateStatus": "BLOCKED",
+		"headRefOid":       "abc123",
+		"autoMergeRequest": nil,
+		"mergeQueueEntry":  nil,
+	}
+	if autoMerge != nil {
+		pr["autoMergeRequest"] = autoMerge
+	}
+	if mergeQueueEntry != nil {
+		pr["mergeQueueEntry"] = mergeQueueEntry
+	}
+	return pr
+}
+
+func mergeStatusQueryMatcher(pr map[string]any) githubv4mock.Matcher {
+	return githubv4mock.NewQueryMatcher(
+		GetPullRequestMergeStatusQuery{},
+		map[string]any{
+			"owner": githubv4.String("owner"),
+			"repo":  githubv4.String("repo"),
+			"prNum": githubv4.Int(42),
+		},
+		githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{"pullRequest": pr},
+		}),
+	)
+}
+
+var mergeToolArgs = map[string]any{
+	"owner":      "owner",
+	"repo":       "repo",
+	"pullNumber": float64(42),
+}
+
+func TestGetPullRequestMergeStatus(t *testing.T) {
+	t.Parallel()
+
+	tool, _ := GetPullRequestMergeStatus(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.Equal(t, "get_pull_request_merge_status", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	mockedClient := githubv4mock.NewMockedHTTPClient(
+		mergeStatusQueryMatcher(mergeStatusResponse(
+			map[string]any{
+				"enabledAt":      "2025-01-02T03:04:05Z",
+				"mergeMethod":    "SQUASH",
+				"commitHeadline": "Ship it",
+				"enabledBy":      map[string]any{"login": "octocat"},
+			},
+			map[string]any{
+				"position":             2,
+				"state":                "AWAITING_CHECKS",
+				"enqueuedAt":           "2025-01-02T03:04:05Z",
+				"estimatedTimeToMerge": 600,
+			},
+		)),
+	)
+	_, handler := GetPullRequestMergeStatus(stubGetGQLClientFn(githubv4.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(mergeToolArgs))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var status PullRequestMergeStatus
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &status))
+	assert.Equal(t, 42, status.Number)
+	assert.Equal(t, "BLOCKED", status.MergeStateStatus)
+	assert.Equal(t, "abc123", status.HeadSHA)
+	require.NotNil(t, status.AutoMerge)
+	assert.Equal(t, "SQUASH", status.AutoMerge.MergeMethod)
+	assert.Equal(t, "octocat", status.AutoMerge.EnabledBy)
+	assert.Equal(t, "Ship it", status.AutoMerge.CommitHeadline)
+	require.NotNil(t, status.MergeQueue)
+	assert.Equal(t, 2, status.MergeQueue.Position)
+	assert.Equal(t, "AWAITING_CHECKS", status.MergeQueue.State)
+	require.NotNil(t, status.MergeQueue.EstimatedSecondsUntilMerged)
+	assert.Equal(t, 600, *status.MergeQueue.EstimatedSecondsUntilMerged)
+}
+
+func TestEnableAndDisablePullRequestAutoMerge(t *testing.T) {
+	t.Parallel()
+
+	enableTool, _ := EnablePullRequestAutoMerge(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(enableTool.Name, enableTool))
+	assert.Equal(t, "enable_pull_request_auto_merge", enableTool.Name)
+	assert.Contains(t, enableTool.InputSchema.Properties, "merge_method")
+	assert.Contains(t, enableTool.InputSchema.Properties, "expected_head_sha")
+	assert.ElementsMatch(t, enableTool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	disableTool, _ := DisablePullRequestAutoMerge(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(disableTool.Name, disableTool))
+	assert.Equal(t, "disable_pull_request_auto_merge", disableTool.Name)
+
+	autoMerge := map[string]any{
+		"enabledAt":      "2025-01-02T03:04:05Z",
+		"mergeMethod":    "SQUASH",
+		"commitHeadline": nil,
+		"enabledBy":      map[string]any{"login": "octocat"},
+	}
+
+	t.Run("enable", func(t *testing.T) {
+		t.Parallel()
+
+		mockedClient := githubv4mock.NewMockedHTTPClient(
+			mergeStatusQueryMatcher(mergeStatusResponse(nil, nil)),
+			githubv4mock.NewMutationMatcher(
+				struct {
+					EnablePullRequestAutoMerge struct {
+						PullRequest PullRequestMergeStatusFragment
+					} `graphql:"enablePullRequestAutoMerge(input: $input)"`
+				}{},
+				githubv4.EnablePullRequestAutoMergeInput{
+					PullRequestID:   githubv4.ID("PR_1"),
+					MergeMethod:     newGQLStringlike[githubv4.PullRequestMergeMethod]("SQUASH"),
+					ExpectedHeadOid: newGQLStringlike[githubv4.GitObjectID]("abc123"),
+				},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"enablePullRequestAutoMerge": map[string]any{
+						"pullRequest": mergeStatusResponse(autoMerge, nil),
+					},
+				}),
+			),
+		)
+		_, handler := EnablePullRequestAutoMerge(stubGetGQLClientFn(githubv4.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":             "owner",
+			"repo":              "repo",
+			"pullNumber":        float64(42),
+			"merge_method":      "squash",
+			"expected_head_sha": "abc123",
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var status PullRequestMergeStatus
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &status))
+		require.NotNil(t, status.AutoMerge)
+		assert.Equal(t, "SQUASH", status.AutoMerge.MergeMethod)
+		assert.Nil(t, status.MergeQueue)
+	})
+
+	t.Run("enable fails when pull request is already mergeable", func(t *testing.T) {
+		t.Parallel()
+
+		mockedClient := githubv4mock.NewMockedHTTPClient(
+			mergeStatusQueryMatcher(mergeStatusResponse(nil, nil)),
+			githubv4mock.NewMutationMatcher(
+				struct {
+					EnablePullRequestAutoMerge struct {
+						PullRequest PullRequestMergeStatusFragment
+					} `graphql:"enablePullRequestAutoMerge(input: $input)"`
+				}{},
+				githubv4.EnablePullRequestAutoMergeInput{PullRequestID: githubv4.ID("PR_1")},
+				nil,
+				githubv4mock.ErrorResponse("Pull request is in clean status"),
+			),
+		)
+		_, handler := EnablePullRequestAutoMerge(stubGetGQLClientFn(githubv4.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(mergeToolArgs))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to enable auto-merge")
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		t.Parallel()
+
+		mockedClient := githubv4mock.NewMockedHTTPClient(
+			mergeStatusQueryMatcher(mergeStatusResponse(autoMerge, nil)),
+			githubv4mock.NewMutationMatcher(
+				struct {
+					DisablePullRequestAutoMerge struct {
+						PullRequest PullRequestMergeStatusFragment
+					} `graphql:"disablePullRequestAutoMerge(input: $input)"`
+				}{},
+				githubv4.DisablePullRequestAutoMergeInput{PullRequestID: githubv4.ID("PR_1")},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"disablePullRequestAutoMerge": map[string]any{
+						"pullRequest": mergeStatusResponse(nil, nil),
+					},
+				}),
+			),
+		)
+		_, handler := DisablePullRequestAutoMerge(stubGetGQLClientFn(githubv4.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(mergeToolArgs))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var status PullRequestMergeStatus
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &status))
+		assert.Nil(t, status.AutoMerge)
+	})
+}
+
+func TestAddAndRemovePullRequestFromMergeQueue(t *testing.T) {
+	t.Parallel()
+
+	addTool, _ := AddPullRequestToMergeQueue(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(addTool.Name, addTool))
+	assert.Equal(t, "add_pull_request_to_merge_queue", addTool.Name)
+	assert.Contains(t, addTool.InputSchema.Properties, "jump")
+	assert.ElementsMatch(t, addTool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	removeTool, _ := RemovePullRequestFromMergeQueue(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(removeTool.Name, removeTool))
+	assert.Equal(t, "remove_pull_request_from_merge_queue", removeTool.Name)
+
+	queueEntry := map[string]any{
+		"position":             1,
+		"state":                "QUEUED",
+		"enqueuedAt":           "2025-01-02T03:04:05Z",
+		"estimatedTimeToMerge": nil,
+	}
+
+	t.Run("add", func(t *testing.T) {
+		t.Parallel()
+
+		mockedClient := githubv4mock.NewMockedHTTPClient(
+			mergeStatusQueryMatcher(mergeStatusResponse(nil, nil)),
+			githubv4mock.NewMutationMatcher(
+				struct {
+					EnqueuePullRequest struct {
+						MergeQueueEntry struct {
+							PullRequest PullRequestMergeStatusFragment
+						}
+					} `graphql:"enqueuePullRequest(input: $input)"`
+				}{},
+				githubv4.EnqueuePullRequestInput{
+					PullRequestID: githubv4.ID("PR_1"),
+					Jump:          githubv4.NewBoolean(true),
+				},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"enqueuePullRequest": map[string]any{
+						"mergeQueueEntry": map[string]any{
+							"pullRequest": mergeStatusResponse(nil, queueEntry),
+						},
+					},
+				}),
+			),
+		)
+		_, handler := AddPullRequestToMergeQueue(stubGetGQLClientFn(githubv4.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":      "owner",
+			"repo":       "repo",
+			"pullNumber": float64(42),
+			"jump":       true,
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var status PullRequestMergeStatus
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &status))
+		require.NotNil(t, status.MergeQueue)
+		assert.Equal(t, 1, status.MergeQueue.Position)
+		assert.Equal(t, "QUEUED", status.MergeQueue.State)
+		assert.Nil(t, status.MergeQueue.EstimatedSecondsUntilMerged)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		t.Parallel()
+
+		mockedClient := githubv4mock.NewMockedHTTPClient(
+			mergeStatusQueryMatcher(mergeStatusResponse(nil, queueEntry)),
+			githubv4mock.NewMutationMatcher(
+				struct {
+					DequeuePullRequest struct {
+						MergeQueueEntry struct {
+							PullRequest PullRequestMergeStatusFragment
+						}
+					} `graphql:"dequeuePullRequest(input: $input)"`
+				}{},
+				githubv4.DequeuePullRequestInput{ID: githubv4.ID("PR_1")},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"dequeuePullRequest": map[string]any{
+						"mergeQueueEntry": map[string]any{
+							"pullRequest": mergeStatusResponse(nil, nil),
+						},
+					},
+				}),
+			),
+		)
+		_, handler := RemovePullRequestFromMergeQueue(stubGetGQLClientFn(githubv4.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(mergeToolArgs))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var status PullRequestMergeStatus
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &status))
+		assert.Nil(t, status.MergeQueue)
+	})
+
+	t.Run("remove fails when not queued", func(t *testing.T) {
+		t.Parallel()
+
+		mockedClient := githubv4mock.NewMockedHTTPClient(
+			mergeStatusQueryMatcher(mergeStatusResponse(nil, nil)),
+		)
+		_, handler := RemovePullRequestFromMergeQueue(stubGetGQLClientFn(githubv4.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(mergeToolArgs))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "pull request #42 is not in a merge queue")
+	})
+}
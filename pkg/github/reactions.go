@@ -0,0 +1,515 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-http/pkg/errors"
+	"github.com/github/github-mcp-http/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// Subjects that reactions can be listed, added and removed on.
+const (
+	reactionSubjectIssue                    = "issue"
+	reactionSubjectIssueComment             = "issue_comment"
+	reactionSubjectPullRequestReviewComment = "pull_request_review_comment"
+	reactionSubjectCommitComment            = "commit_comment"
+	reactionSubjectDiscussion               = "discussion"
+	reactionSubjectDiscussionComment        = "discussion_comment"
+)
+
+// maxReactionPages bounds the number of pages of reactions summarised by list_reactions.
+const maxReactionPages = 10
+
+// reactionContents are the reaction contents in the order they are reported, using the REST API names.
+var reactionContents = []string{"+1", "-1", "laugh", "hooray", "confused", "heart", "rocket", "eyes"}
+
+// graphQLReactionContents maps the REST API reaction names to their GraphQL equivalents.
+var graphQLReactionContents = map[string]githubv4.ReactionContent{
+	"+1":       githubv4.ReactionContentThumbsUp,
+	"-1":       githubv4.ReactionContentThumbsDown,
+	"laugh":    githubv4.ReactionContentLaugh,
+	"hooray":   githubv4.ReactionContentHooray,
+	"confused": githubv4.ReactionContentConfused,
+	"heart":    githubv4.ReactionContentHeart,
+	"rocket":   githubv4.ReactionContentRocket,
+	"eyes":     githubv4.ReactionContentEyes,
+}
+
+// reactionSubject identifies the issue, pull request, comment or discussion a reaction belongs to.
+type reactionSubject struct {
+	kind          string
+	owner         string
+	repo          string
+	number        int
+	commentID     int64
+	commentNodeID string
+}
+
+// withReactionSubject adds the parameters that identify the subject of a reaction.
+func withReactionSubject() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner"),
+		)(tool)
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		)(tool)
+		mcp.WithString("subject_type",
+			mcp.Required(),
+			mcp.Description("What the reactions belong to. Use issue for pull requests themselves, and pull_request_review_comment for comments on the diff of a pull request"),
+			mcp.Enum(
+				reactionSubjectIssue,
+				reactionSubjectIssueComment,
+				reactionSubjectPullRequestReviewComment,
+				reactionSubjectCommitComment,
+				reactionSubjectDiscussion,
+				reactionSubjectDiscussionComment,
+			),
+		)(tool)
+		mcp.WithNumber("number",
+			mcp.Description("Issue, pull request or discussion number. Required for the issue and discussion subject types"),
+		)(tool)
+		mcp.WithNumber("comment_id",
+			mcp.Description("Numeric ID of the comment. Required for the issue_comment, pull_request_review_comment and commit_comment subject types"),
+		)(tool)
+		mcp.WithString("comment_node_id",
+			mcp.Description("Node ID of the discussion comment, as returned by get_discussion_comments. Required for the discussion_comment subject type"),
+		)(tool)
+	}
+}
+
+// reactionSubjectParams reads and validates the parameters added by withReactionSubject.
+func reactionSubjectParams(request mcp.CallToolRequest) (reactionSubject, error) {
+	var subject reactionSubject
+	var err error
+	if subject.owner, err = RequiredParam[string](request, "owner"); err != nil {
+		return subject, err
+	}
+	if subject.repo, err = RequiredParam[string](request, "repo"); err != nil {
+		return subject, err
+	}
+	if subject.kind, err = RequiredParam[string](request, "subject_type"); err != nil {
+		return subject, err
+	}
+
+	switch subject.kind {
+	case reactionSubjectIssue, reactionSubjectDiscussion:
+		subject.number, err = RequiredInt(request, "number")
+	case reactionSubjectIssueComment, reactionSubjectPullRequestReviewComment, reactionSubjectCommitComment:
+		var commentID int
+		commentID, err = RequiredInt(request, "comment_id")
+		subject.commentID = int64(commentID)
+	case reactionSubjectDiscussionComment:
+		subject.commentNodeID, err = RequiredParam[string](request, "comment_node_id")
+	default:
+		err = fmt.Errorf("unsupported subject_type: %s", subject.kind)
+	}
+	return subject, err
+}
+
+// usesGraphQL reports whether the reactions of the subject are only reachable through the GraphQL API.
+func (s reactionSubject) usesGraphQL() bool {
+	return s.kind == reactionSubjectDiscussion || s.kind == reactionSubjectDiscussionComment
+}
+
+func (s reactionSubject) list(ctx context.Context, client *github.Client, opts *github.ListReactionOptions) ([]*github.Reaction, *github.Response, error) {
+	switch s.kind {
+	case reactionSubjectIssue:
+		return client.Reactions.ListIssueReactions(ctx, s.owner, s.repo, s.number, opts)
+	case reactionSubjectIssueComment:
+		return client.Reactions.ListIssueCommentReactions(ctx, s.owner, s.repo, s.commentID, opts)
+	case reactionSubjectPullRequestReviewComment:
+		return client.Reactions.ListPullRequestCommentReactions(ctx, s.owner, s.repo, s.commentID, opts)
+	default:
+		return client.Reactions.ListCommentReactions(ctx, s.owner, s.repo, s.commentID, opts)
+	}
+}
+
+func (s reactionSubject) create(ctx context.Context, client *github.Client, content string) (*github.Reaction, *github.Response, error) {
+	switch s.kind {
+	case reactionSubjectIssue:
+		return client.Reactions.CreateIssueReaction(ctx, s.owner, s.repo, s.number, content)
+	case reactionSubjectIssueComment:
+		return client.Reactions.CreateIssueCommentReaction(ctx, s.owner, s.repo, s.commentID, content)
+	case reactionSubjectPullRequestReviewComment:
+		return client.Reactions.CreatePullRequestCommentReaction(ctx, s.owner, s.repo, s.commentID, content)
+	default:
+		return client.Reactions.CreateCommentReaction(ctx, s.owner, s.repo, s.commentID, content)
+	}
+}
+
+func (s reactionSubject) delete(ctx context.Context, client *github.Client, reactionID int64) (*github.Response, error) {
+	switch s.kind {
+	case reactionSubjectIssue:
+		return client.Reactions.DeleteIssueReaction(ctx, s.owner, s.repo, s.number, reactionID)
+	case reactionSubjectIssueComment:
+		return client.Reactions.DeleteIssueCommentReaction(ctx, s.owner, s.repo, s.commentID, reactionID)
+	case reactionSubjectPullRequestReviewComment:
+		return client.Reactions.DeletePullRequestCommentReaction(ctx, s.owner, s.repo, s.commentID, reactionID)
+	default:
+		return client.Reactions.DeleteCommentReaction(ctx, s.owner, s.repo, s.commentID, reactionID)
+	}
+}
+
+// nodeID resolves the GraphQL node ID of a discussion or discussion comment.
+func (s reactionSubject) nodeID(ctx context.Context, client *githubv4.Client) (githubv4.ID, error) {
+	if s.kind == reactionSubjectDiscussionComment {
+		return githubv4.ID(s.commentNodeID), nil
+	}
+	return getDiscussionID(ctx, client, s.owner, s.repo, s.number)
+}
+
+// ReactionGroupsQuery is the GraphQL query for the reactions of a discussion or discussion comment.
+type ReactionGroupsQuery struct {
+	Node struct {
+		Reactable struct {
+			ReactionGroups []struct {
+				Content  githubv4.ReactionContent
+				Reactors struct {
+					TotalCount githubv4.Int
+					Nodes      []struct {
+						Actor struct {
+							Login githubv4.String
+						} `graphql:"... on Actor"`
+					}
+				} `graphql:"reactors(first: $first)"`
+			}
+		} `graphql:"... on Reactable"`
+	} `graphql:"node(id: $id)"`
+}
+
+// ReactionSummary is the output type of list_reactions.
+type ReactionSummary struct {
+	TotalCount int             `json:"total_count"`
+	Reactions  []ReactionGroup `json:"reactions"`
+	// Truncated is true when there were more reactions than list_reactions summarises, so counts are lower bounds.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// ReactionGroup is the number of reactions with one content, and a sample of who reacted.
+type ReactionGroup struct {
+	Content string   `json:"content"`
+	Count   int      `json:"count"`
+	Users   []string `json:"users"`
+}
+
+// MinimalReaction is the output type for an added reaction.
+type MinimalReaction struct {
+	ID      string `json:"id"`
+	Content string `json:"content"`
+}
+
+// ListReactions creates a tool to summarise the reactions on an issue, pull request, comment or discussion.
+func ListReactions(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_reactions",
+			mcp.WithDescription(t("TOOL_LIST_REACTIONS_DESCRIPTION", "Summarise the reactions on an issue, pull request, comment or discussion: the number of reactions of each kind and a sample of the users who reacted.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REACTIONS_USER_TITLE", "List reactions"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			withReactionSubject(),
+			mcp.WithString("content",
+				mcp.Description("Only count reactions of this kind"),
+				mcp.Enum(reactionContents...),
+			),
+			mcp.WithNumber("users_per_reaction",
+				mcp.Description("Maximum number of users listed for each kind of reaction (default 10)"),
+				mcp.Min(0),
+				mcp.Max(100),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			subject, err := reactionSubjectParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			content, err := OptionalParam[string](request, "content")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			usersPerReaction, err := OptionalIntParamWithDefault(request, "users_per_reaction", 10)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			summary := &ReactionSummary{Reactions: []ReactionGroup{}}
+			groups := map[string]*ReactionGroup{}
+			group := func(content string) *ReactionGroup {
+				if groups[content] == nil {
+					groups[content] = &ReactionGroup{Content: content, Users: []string{}}
+				}
+				return groups[content]
+			}
+
+			if subject.usesGraphQL() {
+				client, err := getGQLClient(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+				}
+				id, err := subject.nodeID(ctx, client)
+				if err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to find discussion", err), nil
+				}
+
+				var query ReactionGroupsQuery
+				if err := client.Query(ctx, &query, map[string]any{
+					"id":    id,
+					"first": githubv4.Int(max(usersPerReaction, 1)), // #nosec G115 - bounded by the schema maximum of 100
+				}); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to list reactions", err), nil
+				}
+
+				for _, reactionGroup := range query.Node.Reactable.ReactionGroups {
+					g := group(restReactionContent(reactionGroup.Content))
+					g.Count = int(reactionGroup.Reactors.TotalCount)
+					for _, reactor := range reactionGroup.Reactors.Nodes {
+						if len(g.Users) < usersPerReaction {
+							g.Users = append(g.Users, string(reactor.Actor.Login))
+						}
+					}
+				}
+			} else {
+				client, err := getClient(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+				}
+
+				opts := &github.ListReactionOptions{
+					Content:     content,
+					ListOptions: github.ListOptions{PerPage: 100},
+				}
+				for page := 0; page < maxReactionPages; page++ {
+					reactions, resp, err := subject.list(ctx, client, opts)
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list reactions", resp, err), nil
+					}
+					_ = resp.Body.Close()
+
+					for _, reaction := range reactions {
+						g := group(reaction.GetContent())
+						g.Count++
+						if len(g.Users) < usersPerReaction {
+							g.Users = append(g.Users, reaction.GetUser().GetLogin())
+						}
+					}
+					if resp.NextPage == 0 {
+						break
+					}
+					if page == maxReactionPages-1 {
+						summary.Truncated = true
+					}
+					opts.Page = resp.NextPage
+				}
+			}
+
+			for _, c := range reactionContents {
+				g := groups[c]
+				if g == nil || g.Count == 0 || (content != "" && c != content) {
+					continue
+				}
+				summary.TotalCount += g.Count
+				summary.Reactions = append(summary.Reactions, *g)
+			}
+
+			return MarshalledTextResult(summary), nil
+		}
+}
+
+// restReactionContent maps a GraphQL reaction content to its REST API name.
+func restReactionContent(content githubv4.ReactionContent) string {
+	for name, graphQLContent := range graphQLReactionContents {
+		if graphQLContent == content {
+			return name
+		}
+	}
+	return string(content)
+}
+
+// AddReaction creates a tool to react to an issue, pull request, comment or discussion.
+func AddReaction(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("add_reaction",
+			mcp.WithDescription(t("TOOL_ADD_REACTION_DESCRIPTION", "Add a reaction to an issue, pull request, comment or discussion as the authenticated user. Adding a reaction the user already made has no effect.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:          t("TOOL_ADD_REACTION_USER_TITLE", "Add reaction"),
+				ReadOnlyHint:   ToBoolPtr(false),
+				IdempotentHint: ToBoolPtr(true),
+			}),
+			withReactionSubject(),
+			mcp.WithString("content",
+				mcp.Required(),
+				mcp.Description("The reaction to add"),
+				mcp.Enum(reactionContents...),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			subject, err := reactionSubjectParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			content, err := RequiredParam[string](request, "content")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			graphQLContent, ok := graphQLReactionContents[content]
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("unsupported reaction content: %s", content)), nil
+			}
+
+			if subject.usesGraphQL() {
+				client, err := getGQLClient(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+				}
+				id, err := subject.nodeID(ctx, client)
+				if err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to find discussion", err), nil
+				}
+
+				var mutation struct {
+					AddReaction struct {
+						Reaction struct {
+							ID githubv4.ID
+						}
+					} `graphql:"addReaction(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &mutation, githubv4.AddReactionInput{
+					SubjectID: id,
+					Content:   graphQLContent,
+				}, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to add reaction", err), nil
+				}
+
+				return MarshalledTextResult(MinimalReaction{
+					ID:      fmt.Sprint(mutation.AddReaction.Reaction.ID),
+					Content: content,
+				}), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			reaction, resp, err := subject.create(ctx, client, content)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to add reaction", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(MinimalReaction{
+				ID:      fmt.Sprint(reaction.GetID()),
+				Content: reaction.GetContent(),
+			}), nil
+		}
+}
+
+// RemoveReaction creates a tool to remove the authenticated user's reaction from an issue, pull request, comment or discussion.
+func RemoveReaction(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_reaction",
+			mcp.WithDescription(t("TOOL_REMOVE_REACTION_DESCRIPTION", "Remove a reaction the authenticated user made on an issue, pull request, comment or discussion.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_REMOVE_REACTION_USER_TITLE", "Remove reaction"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			withReactionSubject(),
+			mcp.WithString("content",
+				mcp.Required(),
+				mcp.Description("The reaction to remove"),
+				mcp.Enum(reactionContents...),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			subject, err := reactionSubjectParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			content, err := RequiredParam[string](request, "content")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			graphQLContent, ok := graphQLReactionContents[content]
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("unsupported reaction content: %s", content)), nil
+			}
+
+			if subject.usesGraphQL() {
+				client, err := getGQLClient(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+				}
+				id, err := subject.nodeID(ctx, client)
+				if err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to find discussion", err), nil
+				}
+
+				var mutation struct {
+					RemoveReaction struct {
+						Reaction struct {
+							ID githubv4.ID
+						}
+					} `graphql:"removeReaction(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &mutation, githubv4.RemoveReactionInput{
+					SubjectID: id,
+					Content:   graphQLContent,
+				}, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to remove reaction", err), nil
+				}
+
+				return mcp.NewToolResultText(fmt.Sprintf("reaction %q removed", content)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// The REST API deletes reactions by ID, so find the authenticated user's reaction first.
+			user, resp, err := client.Users.Get(ctx, "")
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get authenticated user", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			var reactionID int64
+			opts := &github.ListReactionOptions{
+				Content:     content,
+				ListOptions: github.ListOptions{PerPage: 100},
+			}
+			for page := 0; page < maxReactionPages && reactionID == 0; page++ {
+				reactions, resp, err := subject.list(ctx, client, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list reactions", resp, err), nil
+				}
+				_ = resp.Body.Close()
+
+				for _, reaction := range reactions {
+					if reaction.GetUser().GetLogin() == user.GetLogin() {
+						reactionID = reaction.GetID()
+						break
+					}
+				}
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+			if reactionID == 0 {
+				return mcp.NewToolResultError(fmt.Sprintf("%s has no %q reaction to remove", user.GetLogin(), content)), nil
+			}
+
+			resp, err = subject.delete(ctx, client, reactionID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to remove reaction", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("reaction %q removed", content)), nil
+		}
+}
@@ -0,0 +1,430 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-http/internal/githubv4mock"
+	"github.com/github/github-mcp-http/internal/toolsnaps"
+	"github.com/github/github-mcp-http/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func reaction(id int64, login, content string) *github.Reaction {
+	return &github.Reaction{
+		ID:      github.Ptr(id),
+		User:    &github.User{Login: github.Ptr(login)},
+		Content: github.Ptr(content),
+	}
+}
+
+func Test_ListReactions(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListReactions(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_reactions", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "subject_type")
+	assert.Contains(t, tool.InputSchema.Properties, "users_per_reaction")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "subject_type"})
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		mockedGQLClient *http.Client
+		requestArgs     map[string]any
+		expectError     bool
+		expectedErrMsg  string
+		expectedSummary ReactionSummary
+	}{
+		{
+			name: "issue reactions grouped by content",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesReactionsByOwnerByRepoByIssueNumber,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/issues/42/reactions",
+						queryParams: map[string]string{"per_page": "100"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Reaction{
+							reaction(1, "alice", "heart"),
+							reaction(2, "bob", "+1"),
+							reaction(3, "carol", "+1"),
+							reaction(4, "dave", "+1"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":              "owner",
+				"repo":               "repo",
+				"subject_type":       "issue",
+				"number":             float64(42),
+				"users_per_reaction": float64(2),
+			},
+			expectedSummary: ReactionSummary{
+				TotalCount: 4,
+				Reactions: []ReactionGroup{
+					{Content: "+1", Count: 3, Users: []string{"bob", "carol"}},
+					{Content: "heart", Count: 1, Users: []string{"alice"}},
+				},
+			},
+		},
+		{
+			name: "pull request review comment reactions filtered by content",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsCommentsReactionsByOwnerByRepoByCommentId,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/pulls/comments/99/reactions",
+						queryParams: map[string]string{"content": "rocket", "per_page": "100"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Reaction{
+							reaction(5, "alice", "rocket"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "pull_request_review_comment",
+				"comment_id":   float64(99),
+				"content":      "rocket",
+			},
+			expectedSummary: ReactionSummary{
+				TotalCount: 1,
+				Reactions: []ReactionGroup{
+					{Content: "rocket", Count: 1, Users: []string{"alice"}},
+				},
+			},
+		},
+		{
+			name: "discussion reactions",
+			mockedGQLClient: githubv4mock.NewMockedHTTPClient(
+				discussionIDMatcher(),
+				githubv4mock.NewQueryMatcher(
+					ReactionGroupsQuery{},
+					map[string]any{
+						"id":    githubv4.ID("D_kwDOA7"),
+						"first": githubv4.Int(10),
+					},
+					githubv4mock.DataResponse(map[string]any{
+						"node": map[string]any{
+							"reactionGroups": []map[string]any{
+								{"content": "THUMBS_UP", "reactors": map[string]any{"totalCount": 12, "nodes": []map[string]any{{"login": "alice"}, {"login": "bob"}}}},
+								{"content": "CONFUSED", "reactors": map[string]any{"totalCount": 0, "nodes": []map[string]any{}}},
+								{"content": "EYES", "reactors": map[string]any{"totalCount": 1, "nodes": []map[string]any{{"login": "carol"}}}},
+							},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "discussion",
+				"number":       float64(7),
+			},
+			expectedSummary: ReactionSummary{
+				TotalCount: 13,
+				Reactions: []ReactionGroup{
+					{Content: "+1", Count: 12, Users: []string{"alice", "bob"}},
+					{Content: "eyes", Count: 1, Users: []string{"carol"}},
+				},
+			},
+		},
+		{
+			name: "missing comment_id",
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue_comment",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: comment_id",
+		},
+		{
+			name: "list fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommentsReactionsByOwnerByRepoByCommentId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "commit_comment",
+				"comment_id":   float64(3),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list reactions",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			gqlClient := githubv4.NewClient(tc.mockedGQLClient)
+			_, handler := ListReactions(stubGetClientFn(client), stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var summary ReactionSummary
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &summary))
+			assert.Equal(t, tc.expectedSummary, summary)
+		})
+	}
+}
+
+func Test_AddReaction(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AddReaction(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "add_reaction", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "subject_type", "content"})
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		mockedGQLClient  *http.Client
+		requestArgs      map[string]any
+		expectError      bool
+		expectedErrMsg   string
+		expectedReaction MinimalReaction
+	}{
+		{
+			name: "react to issue comment",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesCommentsReactionsByOwnerByRepoByCommentId,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/issues/comments/123/reactions",
+						requestBody: map[string]any{"content": "hooray"},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, reaction(55, "me", "hooray")),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue_comment",
+				"comment_id":   float64(123),
+				"content":      "hooray",
+			},
+			expectedReaction: MinimalReaction{ID: "55", Content: "hooray"},
+		},
+		{
+			name: "react to discussion comment",
+			mockedGQLClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewMutationMatcher(
+					struct {
+						AddReaction struct {
+							Reaction struct {
+								ID githubv4.ID
+							}
+						} `graphql:"addReaction(input: $input)"`
+					}{},
+					githubv4.AddReactionInput{
+						SubjectID: githubv4.ID("DC_kwDOA1"),
+						Content:   githubv4.ReactionContentHeart,
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"addReaction": map[string]any{
+							"reaction": map[string]any{"id": "REA_kwDOA1"},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"subject_type":    "discussion_comment",
+				"comment_node_id": "DC_kwDOA1",
+				"content":         "heart",
+			},
+			expectedReaction: MinimalReaction{ID: "REA_kwDOA1", Content: "heart"},
+		},
+		{
+			name: "unsupported content",
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue",
+				"number":       float64(1),
+				"content":      "party",
+			},
+			expectError:    true,
+			expectedErrMsg: "unsupported reaction content: party",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			gqlClient := githubv4.NewClient(tc.mockedGQLClient)
+			_, handler := AddReaction(stubGetClientFn(client), stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var returned MinimalReaction
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expectedReaction, returned)
+		})
+	}
+}
+
+func Test_RemoveReaction(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RemoveReaction(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "remove_reaction", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "subject_type", "content"})
+
+	authenticatedUser := func() mock.MockBackendOption {
+		return mock.WithRequestMatch(
+			mock.GetUser,
+			&github.User{Login: github.Ptr("me")},
+		)
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		mockedGQLClient *http.Client
+		requestArgs     map[string]any
+		expectError     bool
+		expectedErrMsg  string
+	}{
+		{
+			name: "remove own issue reaction",
+			mockedClient: mock.NewMockedHTTPClient(
+				authenticatedUser(),
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesReactionsByOwnerByRepoByIssueNumber,
+					expectQueryParams(t, map[string]string{"content": "eyes", "per_page": "100"}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Reaction{
+							reaction(1, "alice", "eyes"),
+							reaction(2, "me", "eyes"),
+						}),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposIssuesReactionsByOwnerByRepoByIssueNumberByReactionId,
+					expectPath(t, "/repos/owner/repo/issues/42/reactions/2").andThen(
+						mockResponse(t, http.StatusNoContent, nil),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue",
+				"number":       float64(42),
+				"content":      "eyes",
+			},
+		},
+		{
+			name: "no reaction to remove",
+			mockedClient: mock.NewMockedHTTPClient(
+				authenticatedUser(),
+				mock.WithRequestMatch(
+					mock.GetReposIssuesReactionsByOwnerByRepoByIssueNumber,
+					[]*github.Reaction{reaction(1, "alice", "eyes")},
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue",
+				"number":       float64(42),
+				"content":      "eyes",
+			},
+			expectError:    true,
+			expectedErrMsg: `me has no "eyes" reaction to remove`,
+		},
+		{
+			name: "remove discussion reaction",
+			mockedGQLClient: githubv4mock.NewMockedHTTPClient(
+				discussionIDMatcher(),
+				githubv4mock.NewMutationMatcher(
+					struct {
+						RemoveReaction struct {
+							Reaction struct {
+								ID githubv4.ID
+							}
+						} `graphql:"removeReaction(input: $input)"`
+					}{},
+					githubv4.RemoveReactionInput{
+						SubjectID: githubv4.ID("D_kwDOA7"),
+						Content:   githubv4.ReactionContentThumbsDown,
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"removeReaction": map[string]any{
+							"reaction": map[string]any{"id": "REA_kwDOA2"},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "discussion",
+				"number":       float64(7),
+				"content":      "-1",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			gqlClient := githubv4.NewClient(tc.mockedGQLClient)
+			_, handler := RemoveReaction(stubGetClientFn(client), stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, "removed")
+		})
+	}
+}
@@ -0,0 +1,598 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-http/internal/toolsnaps"
+	"github.com/github/github-mcp-http/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CreateRelease(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateRelease(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_release", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "draft")
+	assert.Contains(t, tool.InputSchema.Properties, "prerelease")
+	assert.Contains(t, tool.InputSchema.Properties, "generate_release_notes")
+	assert.Contains(t, tool.InputSchema.Properties, "make_latest")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "tag"})
+
+	mockRelease := &github.RepositoryRelease{
+		ID:         github.Ptr(int64(1)),
+		TagName:    github.Ptr("v1.2.0"),
+		Name:       github.Ptr("v1.2.0"),
+		Body:       github.Ptr("## What's Changed"),
+		HTMLURL:    github.Ptr("https://github.com/owner/repo/releases/tag/v1.2.0"),
+		Draft:      github.Ptr(true),
+		Prerelease: github.Ptr(true),
+		Author:     &github.User{Login: github.Ptr("octocat"), ID: github.Ptr(int64(7))},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectError    bool
+		expectedResult MinimalRelease
+		expectedErrMsg string
+	}{
+		{
+			name: "draft prerelease with generated notes",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposReleasesByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"tag_name":               "v1.2.0",
+						"target_commitish":       "release/1.2",
+						"draft":                  true,
+						"prerelease":             true,
+						"generate_release_notes": true,
+						"make_latest":            "false",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockRelease),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":                  "owner",
+				"repo":                   "repo",
+				"tag":                    "v1.2.0",
+				"target_commitish":       "release/1.2",
+				"draft":                  true,
+				"prerelease":             true,
+				"generate_release_notes": true,
+				"make_latest":            "false",
+			},
+			expectedResult: MinimalRelease{
+				ID:         1,
+				TagName:    "v1.2.0",
+				Name:       "v1.2.0",
+				Body:       "## What's Changed",
+				HTMLURL:    "https://github.com/owner/repo/releases/tag/v1.2.0",
+				Draft:      true,
+				Prerelease: true,
+				Author:     &MinimalUser{Login: "octocat", ID: 7},
+			},
+		},
+		{
+			name: "release already exists",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposReleasesByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Validation Failed"}),
+				),
+			),
+			args: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"tag":   "v1.2.0",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create release v1.2.0",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateRelease(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned MinimalRelease
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_UpdateRelease(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateRelease(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_release", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "draft")
+	assert.Contains(t, tool.InputSchema.Properties, "tag")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "release_id"})
+
+	publishedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectError    bool
+		expectedResult MinimalRelease
+		expectedErrMsg string
+	}{
+		{
+			name: "publish a draft",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposReleasesByOwnerByRepoByReleaseId,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/releases/1",
+						requestBody: map[string]any{"draft": false, "make_latest": "true"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.RepositoryRelease{
+							ID:          github.Ptr(int64(1)),
+							TagName:     github.Ptr("v1.2.0"),
+							HTMLURL:     github.Ptr("https://github.com/owner/repo/releases/tag/v1.2.0"),
+							Draft:       github.Ptr(false),
+							PublishedAt: &github.Timestamp{Time: publishedAt},
+							Assets: []*github.ReleaseAsset{
+								{ID: github.Ptr(int64(10)), Name: github.Ptr("app.zip"), ContentType: github.Ptr("application/zip"), Size: github.Ptr(1024)},
+							},
+						}),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"release_id":  float64(1),
+				"draft":       false,
+				"make_latest": "true",
+			},
+			expectedResult: MinimalRelease{
+				ID:          1,
+				TagName:     "v1.2.0",
+				HTMLURL:     "https://github.com/owner/repo/releases/tag/v1.2.0",
+				PublishedAt: "2025-01-02T03:04:05Z",
+				Assets: []MinimalReleaseAsset{
+					{ID: 10, Name: "app.zip", ContentType: "application/zip", Size: 1024},
+				},
+			},
+		},
+		{
+			name: "release not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposReleasesByOwnerByRepoByReleaseId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"release_id": float64(99),
+				"name":       "Renamed",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update release 99",
+		},
+		{
+			name:         "nothing to update",
+			mockedClient: mock.NewMockedHTTPClient(),
+			args: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"release_id": float64(99),
+			},
+			expectError:    true,
+			expectedErrMsg: "No update parameters provided.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateRelease(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned MinimalRelease
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_DeleteRelease(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteRelease(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "delete_release", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "release_id"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "delete a release",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposReleasesByOwnerByRepoByReleaseId,
+					expectPath(t, "/repos/owner/repo/releases/1").andThen(
+						mockResponse(t, http.StatusNoContent, nil),
+					),
+				),
+			),
+		},
+		{
+			name: "release not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposReleasesByOwnerByRepoByReleaseId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to delete release 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DeleteRelease(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"release_id": float64(1),
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			assert.Equal(t, "Deleted release 1", getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_GenerateReleaseNotes(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GenerateReleaseNotes(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "generate_release_notes", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.InputSchema.Properties, "previous_tag")
+	assert.Contains(t, tool.InputSchema.Properties, "target_commitish")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "tag"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectError    bool
+		expectedResult github.RepositoryReleaseNotes
+		expectedErrMsg string
+	}{
+		{
+			name: "notes between two tags",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposReleasesGenerateNotesByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"tag_name":          "v1.2.0",
+						"previous_tag_name": "v1.1.0",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.RepositoryReleaseNotes{
+							Name: "v1.2.0",
+							Body: "## What's Changed\n* Fix the parser by @octocat in #42",
+						}),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"tag":          "v1.2.0",
+				"previous_tag": "v1.1.0",
+			},
+			expectedResult: github.RepositoryReleaseNotes{
+				Name: "v1.2.0",
+				Body: "## What's Changed\n* Fix the parser by @octocat in #42",
+			},
+		},
+		{
+			name: "previous tag not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposReleasesGenerateNotesByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"tag":          "v1.2.0",
+				"previous_tag": "v0.0.0",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to generate release notes for v1.2.0",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GenerateReleaseNotes(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned github.RepositoryReleaseNotes
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_UploadReleaseAsset(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UploadReleaseAsset(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "upload_release_asset", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "encoding")
+	assert.Contains(t, tool.InputSchema.Properties, "content_type")
+	assert.Contains(t, tool.InputSchema.Properties, "label")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "release_id", "name", "content"})
+
+	// expectUpload checks the uploaded content and its media type.
+	expectUpload := func(t *testing.T, contentType string, content []byte, asset *github.ReleaseAsset) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, contentType, r.Header.Get("Content-Type"))
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Equal(t, content, body)
+			mockResponse(t, http.StatusCreated, asset)(w, r)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectError    bool
+		expectedResult MinimalReleaseAsset
+		expectedErrMsg string
+	}{
+		{
+			name: "text asset with a label",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposReleasesAssetsByOwnerByRepoByReleaseId,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/releases/1/assets",
+						queryParams: map[string]string{"name": "manifest.json", "label": "Manifest"},
+					}).andThen(
+						expectUpload(t, "application/json", []byte(`{"version":"1.2.0"}`), &github.ReleaseAsset{
+							ID:          github.Ptr(int64(10)),
+							Name:        github.Ptr("manifest.json"),
+							Label:       github.Ptr("Manifest"),
+							ContentType: github.Ptr("application/json"),
+							Size:        github.Ptr(19),
+						}),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"release_id": float64(1),
+				"name":       "manifest.json",
+				"content":    `{"version":"1.2.0"}`,
+				"label":      "Manifest",
+			},
+			expectedResult: MinimalReleaseAsset{ID: 10, Name: "manifest.json", Label: "Manifest", ContentType: "application/json", Size: 19},
+		},
+		{
+			name: "binary asset",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposReleasesAssetsByOwnerByRepoByReleaseId,
+					expectUpload(t, "application/octet-stream", []byte{0x7f, 'E', 'L', 'F', 0x00, 0xff}, &github.ReleaseAsset{
+						ID:          github.Ptr(int64(11)),
+						Name:        github.Ptr("app"),
+						ContentType: github.Ptr("application/octet-stream"),
+						Size:        github.Ptr(6),
+					}),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"release_id": float64(1),
+				"name":       "app",
+				"content":    "f0VMRgD/",
+				"encoding":   "base64",
+			},
+			expectedResult: MinimalReleaseAsset{ID: 11, Name: "app", ContentType: "application/octet-stream", Size: 6},
+		},
+		{
+			name:         "invalid base64 content",
+			mockedClient: mock.NewMockedHTTPClient(),
+			args: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"release_id": float64(1),
+				"name":       "app",
+				"content":    "not base64!",
+				"encoding":   "base64",
+			},
+			expectError:    true,
+			expectedErrMsg: "content is not valid base64",
+		},
+		{
+			name: "asset name already exists",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposReleasesAssetsByOwnerByRepoByReleaseId,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Validation Failed"}),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"release_id": float64(1),
+				"name":       "app.zip",
+				"content":    "zip",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to upload app.zip",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UploadReleaseAsset(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned MinimalReleaseAsset
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_DeleteReleaseAsset(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteReleaseAsset(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "delete_release_asset", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "asset_id"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "delete an asset",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposReleasesAssetsByOwnerByRepoByAssetId,
+					expectPath(t, "/repos/owner/repo/releases/assets/10").andThen(
+						mockResponse(t, http.StatusNoContent, nil),
+					),
+				),
+			),
+		},
+		{
+			name: "asset not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposReleasesAssetsByOwnerByRepoByAssetId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to delete release asset 10",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DeleteReleaseAsset(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"asset_id": float64(10),
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			assert.Equal(t, "Deleted release asset 10", getTextResult(t, result).Text)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/url"
 	"strings"
@@ -16,6 +17,7 @@ import (
 	"github.com/google/go-github/v74/github"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
 )
 
 func GetCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
@@ -1035,10 +1037,91 @@ func CreateBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (
 		}
 }
 
+// File modes supported by push_files.
+const (
+	fileModeRegular    = "100644"
+	fileModeExecutable = "100755"
+	fileModeSymlink    = "120000"
+)
+
+// pushFile is a file change of push_files.
+type pushFile struct {
+	operation string
+	path      string
+	fromPath  string
+	mode      string
+	content   *string
+	base64    bool
+}
+
+// parsePushFiles validates the files parameter of push_files.
+func parsePushFiles(filesObj []interface{}) ([]pushFile, error) {
+	files := make([]pushFile, 0, len(filesObj))
+	for _, file := range filesObj {
+		fileMap, ok := file.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("each file must be an object with path and content")
+		}
+
+		f := pushFile{operation: "update"}
+		f.path, ok = fileMap["path"].(string)
+		if !ok || f.path == "" {
+			return nil, fmt.Errorf("each file must have a path")
+		}
+		if operation, ok := fileMap["operation"].(string); ok && operation != "" {
+			f.operation = operation
+		}
+		f.mode, _ = fileMap["mode"].(string)
+		if content, ok := fileMap["content"].(string); ok {
+			f.content = &content
+		}
+		if encoding, ok := fileMap["encoding"].(string); ok {
+			switch encoding {
+			case "", "utf-8":
+			case "base64":
+				f.base64 = true
+			default:
+				return nil, fmt.Errorf("invalid encoding %q for %s, must be utf-8 or base64", encoding, f.path)
+			}
+		}
+
+		switch f.mode {
+		case "", fileModeRegular, fileModeExecutable, fileModeSymlink:
+		default:
+			return nil, fmt.Errorf("invalid mode %q for %s, must be %s, %s or %s", f.mode, f.path, fileModeRegular, fileModeExecutable, fileModeSymlink)
+		}
+
+		switch f.operation {
+		case "add", "update":
+			if f.content == nil {
+				return nil, fmt.Errorf("each file must have content, missing for %s", f.path)
+			}
+		case "delete":
+			if f.content != nil {
+				return nil, fmt.Errorf("content cannot be given to delete %s", f.path)
+			}
+		case "rename":
+			f.fromPath, _ = fileMap["from_path"].(string)
+			if f.fromPath == "" {
+				return nil, fmt.Errorf("from_path is required to rename %s", f.path)
+			}
+		default:
+			return nil, fmt.Errorf("invalid operation %q for %s, must be add, update, delete or rename", f.operation, f.path)
+		}
+		if f.mode == "" && f.operation != "rename" {
+			// Renamed files keep their mode unless one is given.
+			f.mode = fileModeRegular
+		}
+
+		files = append(files, f)
+	}
+	return files, nil
+}
+
 // PushFiles creates a tool to push multiple files in a single commit to a GitHub repository.
 func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("push_files",
-			mcp.WithDescription(t("TOOL_PUSH_FILES_DESCRIPTION", "Push multiple files to a GitHub repository in a single commit")),
+			mcp.WithDescription(t("TOOL_PUSH_FILES_DESCRIPTION", "Push multiple file changes to a GitHub repository in a single commit. Files can be added, updated, deleted or renamed, with text or base64 content, as regular files, executables or symlinks.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_PUSH_FILES_USER_TITLE", "Push files to repository"),
 				ReadOnlyHint: ToBoolPtr(false),
@@ -1061,7 +1144,7 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 					map[string]interface{}{
 						"type":                 "object",
 						"additionalProperties": false,
-						"required":             []string{"path", "content"},
+						"required":             []string{"path"},
 						"properties": map[string]interface{}{
 							"path": map[string]interface{}{
 								"type":        "string",
@@ -1069,16 +1152,38 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 							},
 							"content": map[string]interface{}{
 								"type":        "string",
-								"description": "file content",
+								"description": "file content, or the target path of a symlink; required to add or update a file",
+							},
+							"operation": map[string]interface{}{
+								"type":        "string",
+								"description": "change to make to the file (default: update)",
+								"enum":        []string{"add", "update", "delete", "rename"},
+							},
+							"from_path": map[string]interface{}{
+								"type":        "string",
+								"description": "path of the file to rename to path; required to rename a file",
+							},
+							"mode": map[string]interface{}{
+								"type":        "string",
+								"description": "file mode: 100644 for a regular file, 100755 for an executable or 120000 for a symlink (default: 100644, or the current mode of a renamed file)",
+								"enum":        []string{fileModeRegular, fileModeExecutable, fileModeSymlink},
+							},
+							"encoding": map[string]interface{}{
+								"type":        "string",
+								"description": "encoding of content, base64 for binary files (default: utf-8)",
+								"enum":        []string{"utf-8", "base64"},
 							},
 						},
 					}),
-				mcp.Description("Array of file objects to push, each object with path (string) and content (string)"),
+				mcp.Description("Array of file changes to push, each object with path (string), content (string) and optionally operation, from_path, mode and encoding"),
 			),
 			mcp.WithString("message",
 				mcp.Required(),
 				mcp.Description("Commit message"),
 			),
+			mcp.WithString("base_sha",
+				mcp.Description("SHA the branch is expected to point at. The push fails without changes if the branch has moved"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -1097,12 +1202,20 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			baseSHA, err := OptionalParam[string](request, "base_sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			// Parse files parameter - this should be an array of objects with path and content
 			filesObj, ok := request.GetArguments()["files"].([]interface{})
 			if !ok {
 				return mcp.NewToolResultError("files parameter must be an array of objects with path and content"), nil
 			}
+			files, err := parsePushFiles(filesObj)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
@@ -1120,6 +1233,12 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			}
 			defer func() { _ = resp.Body.Close() }()
 
+			parentSHA := ref.GetObject().GetSHA()
+			if baseSHA != "" && parentSHA != baseSHA {
+				return ghErrors.NewToolResultErrorWithCategory(ghErrors.CategoryConflict,
+					fmt.Sprintf("branch %s has moved from %s to %s, no changes were pushed", branch, baseSHA, parentSHA)), nil
+			}
+
 			// Get the commit object that the branch points to
 			baseCommit, resp, err := client.Git.GetCommit(ctx, owner, repo, *ref.Object.SHA)
 			if err != nil {
@@ -1131,32 +1250,91 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			}
 			defer func() { _ = resp.Body.Close() }()
 
+			// Renamed files without new content keep their blob, which has to be looked up in the base tree.
+			var baseEntries map[string]*github.TreeEntry
+			for _, f := range files {
+				if f.operation != "rename" || (f.content != nil && f.mode != "") {
+					continue
+				}
+				baseTree, resp, err := client.Git.GetTree(ctx, owner, repo, baseCommit.GetTree().GetSHA(), true)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to get base tree",
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				if baseTree.GetTruncated() {
+					return mcp.NewToolResultError("the tree of the branch is too large to look up renamed files, give the content and mode of each renamed file"), nil
+				}
+				baseEntries = make(map[string]*github.TreeEntry, len(baseTree.Entries))
+				for _, entry := range baseTree.Entries {
+					baseEntries[entry.GetPath()] = entry
+				}
+				break
+			}
+
 			// Create tree entries for all files
 			var entries []*github.TreeEntry
 
-			for _, file := range filesObj {
-				fileMap, ok := file.(map[string]interface{})
-				if !ok {
-					return mcp.NewToolResultError("each file must be an object with path and content"), nil
+			for _, f := range files {
+				if f.operation == "delete" {
+					// An entry without SHA or content removes the file.
+					entries = append(entries, &github.TreeEntry{
+						Path: github.Ptr(f.path),
+						Mode: github.Ptr(fileModeRegular),
+						Type: github.Ptr("blob"),
+					})
+					continue
 				}
 
-				path, ok := fileMap["path"].(string)
-				if !ok || path == "" {
-					return mcp.NewToolResultError("each file must have a path"), nil
+				entry := &github.TreeEntry{
+					Path: github.Ptr(f.path),
+					Mode: github.Ptr(f.mode),
+					Type: github.Ptr("blob"),
 				}
-
-				content, ok := fileMap["content"].(string)
-				if !ok {
-					return mcp.NewToolResultError("each file must have content"), nil
+				if f.operation == "rename" {
+					entries = append(entries, &github.TreeEntry{
+						Path: github.Ptr(f.fromPath),
+						Mode: github.Ptr(fileModeRegular),
+						Type: github.Ptr("blob"),
+					})
+					if baseEntries != nil {
+						original, ok := baseEntries[f.fromPath]
+						if !ok || original.GetType() != "blob" {
+							return mcp.NewToolResultError(fmt.Sprintf("cannot rename %s: no such file on branch %s", f.fromPath, branch)), nil
+						}
+						if f.mode == "" {
+							entry.Mode = original.Mode
+						}
+						if f.content == nil {
+							entry.SHA = original.SHA
+						}
+					}
 				}
 
-				// Create a tree entry for the file
-				entries = append(entries, &github.TreeEntry{
-					Path:    github.Ptr(path),
-					Mode:    github.Ptr("100644"), // Regular file mode
-					Type:    github.Ptr("blob"),
-					Content: github.Ptr(content),
-				})
+				switch {
+				case f.content == nil:
+				case f.base64:
+					// Binary content has to be uploaded as a blob first.
+					blob, resp, err := client.Git.CreateBlob(ctx, owner, repo, &github.Blob{
+						Content:  f.content,
+						Encoding: github.Ptr("base64"),
+					})
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx,
+							fmt.Sprintf("failed to create blob for %s", f.path),
+							resp,
+							err,
+						), nil
+					}
+					_ = resp.Body.Close()
+					entry.SHA = blob.SHA
+				default:
+					entry.Content = f.content
+				}
+				entries = append(entries, entry)
 			}
 
 			// Create a new tree with the file entries
@@ -1186,10 +1364,23 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			// Update the reference to point to the new commit
+			// Update the reference to point to the new commit. This is not a fast-forward,
+			// and so fails, if the branch moved since it was read.
 			ref.Object.SHA = newCommit.SHA
 			updatedRef, resp, err := client.Git.UpdateRef(ctx, owner, repo, ref, false)
 			if err != nil {
+				// A 422 is also returned for protected branches and required checks, so the
+				// branch is only reported as moved when it no longer points to the parent.
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+					current, currentResp, currentErr := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+branch)
+					if currentErr == nil {
+						_ = currentResp.Body.Close()
+						if currentSHA := current.GetObject().GetSHA(); currentSHA != parentSHA {
+							return ghErrors.NewToolResultErrorWithCategory(ghErrors.CategoryConflict,
+								fmt.Sprintf("branch %s moved from %s to %s while pushing, no changes were pushed", branch, parentSHA, currentSHA)), nil
+						}
+					}
+				}
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
 					"failed to update reference",
 					resp,
@@ -1911,3 +2102,726 @@ func UnstarRepository(getClient GetClientFn, t translations.TranslationHelperFun
 			return mcp.NewToolResultText(fmt.Sprintf("Successfully unstarred repository %s/%s", owner, repo)), nil
 		}
 }
+
+// codeownersPaths are the locations GitHub looks for a CODEOWNERS file in, in order.
+var codeownersPaths = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// maxCodeownersRules bounds how many CODEOWNERS rules get_repository returns.
+const maxCodeownersRules = 50
+
+// CodeownersRule is a pattern of a CODEOWNERS file and its owners.
+type CodeownersRule struct {
+	Pattern string   `json:"pattern"`
+	Owners  []string `json:"owners"`
+}
+
+// CodeownersSummary summarizes the CODEOWNERS file of a repository.
+type CodeownersSummary struct {
+	Path      string           `json:"path"`
+	RuleCount int              `json:"rule_count"`
+	Owners    []string         `json:"owners"`
+	Rules     []CodeownersRule `json:"rules"`
+	Truncated bool             `json:"truncated,omitempty"`
+}
+
+// parseCodeowners summarizes the content of a CODEOWNERS file.
+func parseCodeowners(path, content string) *CodeownersSummary {
+	summary := &CodeownersSummary{Path: path, Owners: []string{}, Rules: []CodeownersRule{}}
+	seenOwners := map[string]bool{}
+	for _, line := range strings.Split(content, "\n") {
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		summary.RuleCount++
+		for _, owner := range fields[1:] {
+			if !seenOwners[owner] {
+				seenOwners[owner] = true
+				summary.Owners = append(summary.Owners, owner)
+			}
+		}
+		if len(summary.Rules) == maxCodeownersRules {
+			summary.Truncated = true
+			continue
+		}
+		owners := fields[1:]
+		if owners == nil {
+			owners = []string{}
+		}
+		summary.Rules = append(summary.Rules, CodeownersRule{Pattern: fields[0], Owners: owners})
+	}
+	return summary
+}
+
+// RepositoryDetails is the output type of get_repository.
+type RepositoryDetails struct {
+	MinimalRepository
+	Homepage     string `json:"homepage,omitempty"`
+	Visibility   string `json:"visibility"`
+	Parent       string `json:"parent,omitempty"`
+	IsTemplate   bool   `json:"is_template"`
+	Disabled     bool   `json:"disabled,omitempty"`
+	License      string `json:"license,omitempty"`
+	PushedAt     string `json:"pushed_at,omitempty"`
+	SizeKB       int    `json:"size_kb"`
+	Watchers     int    `json:"subscribers_count"`
+	MyPermission string `json:"my_permission,omitempty"`
+	Features     struct {
+		Issues      bool `json:"has_issues"`
+		Projects    bool `json:"has_projects"`
+		Wiki        bool `json:"has_wiki"`
+		Discussions bool `json:"has_discussions"`
+	} `json:"features"`
+	MergeSettings struct {
+		AllowMergeCommit         bool   `json:"allow_merge_commit"`
+		AllowSquashMerge         bool   `json:"allow_squash_merge"`
+		AllowRebaseMerge         bool   `json:"allow_rebase_merge"`
+		AllowAutoMerge           bool   `json:"allow_auto_merge"`
+		AllowUpdateBranch        bool   `json:"allow_update_branch"`
+		DeleteBranchOnMerge      bool   `json:"delete_branch_on_merge"`
+		SquashMergeCommitTitle   string `json:"squash_merge_commit_title,omitempty"`
+		SquashMergeCommitMessage string `json:"squash_merge_commit_message,omitempty"`
+		MergeCommitTitle         string `json:"merge_commit_title,omitempty"`
+		MergeCommitMessage       string `json:"merge_commit_message,omitempty"`
+	} `json:"merge_settings"`
+	// Languages maps each language to its share of the code in percent.
+	Languages  map[string]float64 `json:"languages"`
+	Codeowners *CodeownersSummary `json:"codeowners,omitempty"`
+}
+
+func convertToRepositoryDetails(repo *github.Repository) RepositoryDetails {
+	details := RepositoryDetails{
+		MinimalRepository: convertToMinimalRepository(repo),
+		Homepage:          repo.GetHomepage(),
+		Visibility:        repo.GetVisibility(),
+		Parent:            repo.GetParent().GetFullName(),
+		IsTemplate:        repo.GetIsTemplate(),
+		Disabled:          repo.GetDisabled(),
+		License:           repo.GetLicense().GetSPDXID(),
+		SizeKB:            repo.GetSize(),
+		Watchers:          repo.GetSubscribersCount(),
+		MyPermission:      highestPermission(repo.GetPermissions()),
+		Languages:         map[string]float64{},
+	}
+	if repo.PushedAt != nil {
+		details.PushedAt = repo.PushedAt.Format("2006-01-02T15:04:05Z")
+	}
+	details.Features.Issues = repo.GetHasIssues()
+	details.Features.Projects = repo.GetHasProjects()
+	details.Features.Wiki = repo.GetHasWiki()
+	details.Features.Discussions = repo.GetHasDiscussions()
+	details.MergeSettings.AllowMergeCommit = repo.GetAllowMergeCommit()
+	details.MergeSettings.AllowSquashMerge = repo.GetAllowSquashMerge()
+	details.MergeSettings.AllowRebaseMerge = repo.GetAllowRebaseMerge()
+	details.MergeSettings.AllowAutoMerge = repo.GetAllowAutoMerge()
+	details.MergeSettings.AllowUpdateBranch = repo.GetAllowUpdateBranch()
+	details.MergeSettings.DeleteBranchOnMerge = repo.GetDeleteBranchOnMerge()
+	details.MergeSettings.SquashMergeCommitTitle = repo.GetSquashMergeCommitTitle()
+	details.MergeSettings.SquashMergeCommitMessage = repo.GetSquashMergeCommitMessage()
+	details.MergeSettings.MergeCommitTitle = repo.GetMergeCommitTitle()
+	details.MergeSettings.MergeCommitMessage = repo.GetMergeCommitMessage()
+	return details
+}
+
+// GetRepository creates a tool to get the metadata and settings of a repository.
+func GetRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_DESCRIPTION", "Get the metadata and settings of a repository: visibility, default branch, topics, enabled features, merge settings, languages and a summary of its CODEOWNERS file.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPOSITORY_USER_TITLE", "Get repository"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository", resp, err), nil
+			}
+			_ = resp.Body.Close()
+			details := convertToRepositoryDetails(repository)
+
+			languages, resp, err := client.Repositories.ListLanguages(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list repository languages", resp, err), nil
+			}
+			_ = resp.Body.Close()
+			total := 0
+			for _, size := range languages {
+				total += size
+			}
+			// Repositories without code, such as empty ones, have no language breakdown.
+			if total > 0 {
+				for language, size := range languages {
+					details.Languages[language] = math.Round(float64(size)*1000/float64(total)) / 10
+				}
+			}
+
+			// A 404 means there is no CODEOWNERS file at that location, or that the repository is empty.
+			for _, path := range codeownersPaths {
+				file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, nil)
+				if err != nil {
+					if resp != nil && resp.StatusCode == http.StatusNotFound {
+						continue
+					}
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get %s", path), resp, err), nil
+				}
+				_ = resp.Body.Close()
+				if file == nil {
+					continue
+				}
+				content, err := file.GetContent()
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to decode %s: %v", path, err)), nil
+				}
+				details.Codeowners = parseCodeowners(path, content)
+				break
+			}
+
+			return MarshalledTextResult(details), nil
+		}
+}
+
+// UpdateRepository creates a tool to update the settings and topics of a repository, and to archive or unarchive it.
+func UpdateRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_repository",
+			mcp.WithDescription(t("TOOL_UPDATE_REPOSITORY_DESCRIPTION", "Update the settings of a repository, replace its topics, or archive or unarchive it. Only the provided settings change. Requires admin access to the repository. Archived repositories are read-only, so unarchive a repository before changing anything else.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_UPDATE_REPOSITORY_USER_TITLE", "Update repository"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("name",
+				mcp.Description("New name of the repository"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Repository description"),
+			),
+			mcp.WithString("homepage",
+				mcp.Description("URL of the repository's homepage"),
+			),
+			mcp.WithString("visibility",
+				mcp.Description("Repository visibility, internal is only available to organizations of an enterprise"),
+				mcp.Enum("public", "private", "internal"),
+			),
+			mcp.WithString("default_branch",
+				mcp.Description("Name of the default branch, which has to exist"),
+			),
+			mcp.WithArray("topics",
+				mcp.Description("Topics replacing the current ones, an empty array removes every topic"),
+				mcp.Items(map[string]any{"type": "string"}),
+			),
+			mcp.WithBoolean("has_issues",
+				mcp.Description("Whether issues are enabled"),
+			),
+			mcp.WithBoolean("has_projects",
+				mcp.Description("Whether projects are enabled"),
+			),
+			mcp.WithBoolean("has_wiki",
+				mcp.Description("Whether the wiki is enabled"),
+			),
+			mcp.WithBoolean("has_discussions",
+				mcp.Description("Whether discussions are enabled"),
+			),
+			mcp.WithBoolean("is_template",
+				mcp.Description("Whether the repository is a template repository"),
+			),
+			mcp.WithBoolean("allow_merge_commit",
+				mcp.Description("Whether pull requests can be merged with a merge commit"),
+			),
+			mcp.WithBoolean("allow_squash_merge",
+				mcp.Description("Whether pull requests can be squash merged"),
+			),
+			mcp.WithBoolean("allow_rebase_merge",
+				mcp.Description("Whether pull requests can be rebase merged"),
+			),
+			mcp.WithBoolean("allow_auto_merge",
+				mcp.Description("Whether auto-merge can be enabled on pull requests"),
+			),
+			mcp.WithBoolean("allow_update_branch",
+				mcp.Description("Whether pull request branches can always be updated from their base branch"),
+			),
+			mcp.WithBoolean("delete_branch_on_merge",
+				mcp.Description("Whether head branches are deleted when their pull requests are merged"),
+			),
+			mcp.WithBoolean("archived",
+				mcp.Description("Archive the repository when true, unarchive it when false"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			edit := &github.Repository{}
+			hasEdits := false
+			for param, field := range map[string]**string{
+				"name":           &edit.Name,
+				"description":    &edit.Description,
+				"homepage":       &edit.Homepage,
+				"visibility":     &edit.Visibility,
+				"default_branch": &edit.DefaultBranch,
+			} {
+				value, ok, err := OptionalParamOK[string](request, param)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if ok {
+					*field = github.Ptr(value)
+					hasEdits = true
+				}
+			}
+			for param, field := range map[string]**bool{
+				"has_issues":             &edit.HasIssues,
+				"has_projects":           &edit.HasProjects,
+				"has_wiki":               &edit.HasWiki,
+				"has_discussions":        &edit.HasDiscussions,
+				"is_template":            &edit.IsTemplate,
+				"allow_merge_commit":     &edit.AllowMergeCommit,
+				"allow_squash_merge":     &edit.AllowSquashMerge,
+				"allow_rebase_merge":     &edit.AllowRebaseMerge,
+				"allow_auto_merge":       &edit.AllowAutoMerge,
+				"allow_update_branch":    &edit.AllowUpdateBranch,
+				"delete_branch_on_merge": &edit.DeleteBranchOnMerge,
+			} {
+				value, ok, err := OptionalParamOK[bool](request, param)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if ok {
+					*field = github.Ptr(value)
+					hasEdits = true
+				}
+			}
+			topics, topicsProvided, err := optionalStringArrayParamOK(request, "topics")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			archived, archivedProvided, err := OptionalParamOK[bool](request, "archived")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !hasEdits && !topicsProvided && !archivedProvided {
+				return mcp.NewToolResultError("No update parameters provided."), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Archived repositories can't be changed, so unarchive first and archive last.
+			if archivedProvided && !archived {
+				_, resp, err := client.Repositories.Edit(ctx, owner, repo, &github.Repository{Archived: github.Ptr(false)})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to unarchive repository", resp, err), nil
+				}
+				_ = resp.Body.Close()
+			}
+			if hasEdits {
+				repository, resp, err := client.Repositories.Edit(ctx, owner, repo, edit)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to update repository", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				// Later requests have to use the new name.
+				repo = repository.GetName()
+			}
+			if topicsProvided {
+				_, resp, err := client.Repositories.ReplaceAllTopics(ctx, owner, repo, topics)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to replace repository topics", resp, err), nil
+				}
+				_ = resp.Body.Close()
+			}
+			if archivedProvided && archived {
+				_, resp, err := client.Repositories.Edit(ctx, owner, repo, &github.Repository{Archived: github.Ptr(true)})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to archive repository", resp, err), nil
+				}
+				_ = resp.Body.Close()
+			}
+
+			updated, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(convertToRepositoryDetails(updated)), nil
+		}
+}
+
+// FilePatch is the patch of a file changed between two refs.
+type FilePatch struct {
+	Filename  string `json:"filename"`
+	Patch     string `json:"patch"`
+	Truncated bool   `json:"truncated,omitempty"`
+}
+
+// CompareRefsResult is the output type of compare_refs.
+type CompareRefsResult struct {
+	Status          string              `json:"status"`
+	AheadBy         int                 `json:"ahead_by"`
+	BehindBy        int                 `json:"behind_by"`
+	TotalCommits    int                 `json:"total_commits"`
+	MergeBase       *MinimalCommit      `json:"merge_base,omitempty"`
+	HTMLURL         string              `json:"html_url,omitempty"`
+	Commits         []MinimalCommit     `json:"commits"`
+	Files           []MinimalCommitFile `json:"files"`
+	Patches         []FilePatch         `json:"patches,omitempty"`
+	NextPatchOffset int                 `json:"next_patch_offset,omitempty"`
+}
+
+// pagePatches collects the patches of files from offset on, until they add up to maxLines lines.
+// It returns the offset of the first file left out, or 0 when every remaining patch fits.
+// A single patch longer than maxLines is truncated, so that every page makes progress.
+// maxLines below one is treated as one.
+func pagePatches(files []*github.CommitFile, offset, maxLines int) ([]FilePatch, int) {
+	maxLines = max(maxLines, 1)
+	patches := []FilePatch{}
+	lines := 0
+	for i := offset; i < len(files); i++ {
+		patch := files[i].GetPatch()
+		if patch == "" {
+			continue
+		}
+		patchLines := strings.Split(patch, "\n")
+		if lines+len(patchLines) > maxLines {
+			if len(patches) > 0 {
+				return patches, i
+			}
+			next := i + 1
+			if next == len(files) {
+				next = 0
+			}
+			return []FilePatch{{
+				Filename:  files[i].GetFilename(),
+				Patch:     strings.Join(patchLines[:maxLines], "\n"),
+				Truncated: true,
+			}}, next
+		}
+		lines += len(patchLines)
+		patches = append(patches, FilePatch{Filename: files[i].GetFilename(), Patch: patch})
+	}
+	return patches, 0
+}
+
+// CompareRefs creates a tool to compare two refs of a repository.
+func CompareRefs(getClient GetClientFn, t translations.TranslationHelperFunc, contentWindowSize int) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("compare_refs",
+			mcp.WithDescription(t("TOOL_COMPARE_REFS_DESCRIPTION", "Compare two refs (branches, tags or commit SHAs) of a repository: how far head is ahead of and behind base, their merge base, the commits on head that are not on base, and the files changed since the merge base. Refs of a fork can be given as owner:branch. Set include_patches to get the diffs, which are paged with patch_offset when they are long.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_COMPARE_REFS_USER_TITLE", "Compare refs"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("base",
+				mcp.Required(),
+				mcp.Description("Base ref, e.g. v1.2.0"),
+			),
+			mcp.WithString("head",
+				mcp.Required(),
+				mcp.Description("Head ref, e.g. main"),
+			),
+			mcp.WithBoolean("include_patches",
+				mcp.Description("Include the diffs of the changed files (default: false)"),
+			),
+			mcp.WithNumber("patch_offset",
+				mcp.Description("Index of the first file to include the diff of, from next_patch_offset of a previous call (default: 0)"),
+				mcp.Min(0),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := RequiredParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			head, err := RequiredParam[string](request, "head")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includePatches, err := OptionalParam[bool](request, "include_patches")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			patchOffset, err := OptionalIntParam(request, "patch_offset")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			comparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, base, head, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to compare %s...%s", base, head), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := CompareRefsResult{
+				Status:       comparison.GetStatus(),
+				AheadBy:      comparison.GetAheadBy(),
+				BehindBy:     comparison.GetBehindBy(),
+				TotalCommits: comparison.GetTotalCommits(),
+				HTMLURL:      comparison.GetHTMLURL(),
+				Commits:      make([]MinimalCommit, 0, len(comparison.Commits)),
+				Files:        make([]MinimalCommitFile, 0, len(comparison.Files)),
+			}
+			if comparison.MergeBaseCommit != nil {
+				mergeBase := convertToMinimalCommit(comparison.MergeBaseCommit, false)
+				result.MergeBase = &mergeBase
+			}
+			for _, commit := range comparison.Commits {
+				result.Commits = append(result.Commits, convertToMinimalCommit(commit, false))
+			}
+			for _, file := range comparison.Files {
+				result.Files = append(result.Files, convertToMinimalCommitFile(file))
+			}
+			if includePatches {
+				result.Patches, result.NextPatchOffset = pagePatches(comparison.Files, patchOffset, contentWindowSize)
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// FileBlameQuery is the GraphQL query for the blame of a file at a commit.
+type FileBlameQuery struct {
+	Repository struct {
+		Object struct {
+			Commit struct {
+				Oid   githubv4.GitObjectID
+				Blame struct {
+					Ranges []struct {
+						StartingLine githubv4.Int
+						EndingLine   githubv4.Int
+						Commit       struct {
+							Oid             githubv4.GitObjectID
+							MessageHeadline githubv4.String
+							CommittedDate   githubv4.DateTime
+							URL             githubv4.URI
+							Author          struct {
+								Name githubv4.String
+								User struct {
+									Login githubv4.String
+								}
+							}
+							AssociatedPullRequests struct {
+								Nodes []struct {
+									Number githubv4.Int
+								}
+							} `graphql:"associatedPullRequests(first: 1)"`
+						}
+					}
+				} `graphql:"blame(path: $path)"`
+			} `graphql:"... on Commit"`
+		} `graphql:"object(expression: $ref)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// BlameRange is the output type for a range of lines last changed by the same commit.
+type BlameRange struct {
+	StartLine   int    `json:"start_line"`
+	EndLine     int    `json:"end_line"`
+	SHA         string `json:"sha"`
+	Author      string `json:"author,omitempty"`
+	AuthorLogin string `json:"author_login,omitempty"`
+	Date        string `json:"date,omitempty"`
+	Message     string `json:"message"`
+	URL         string `json:"url,omitempty"`
+	// PullRequest is the number of the pull request that introduced the commit, if any.
+	PullRequest int `json:"pull_request,omitempty"`
+}
+
+// FileBlameResult is the output type of get_file_blame.
+type FileBlameResult struct {
+	Path   string       `json:"path"`
+	SHA    string       `json:"sha"`
+	Ranges []BlameRange `json:"ranges"`
+}
+
+// GetFileBlame creates a tool to get the blame of a file, optionally limited to a range of lines.
+func GetFileBlame(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_file_blame",
+			mcp.WithDescription(t("TOOL_GET_FILE_BLAME_DESCRIPTION", "Get the blame of a file in a GitHub repository: for each range of lines, the commit that last changed it, with its author, date, message headline and the pull request that introduced it. Use start_line and end_line to limit the output to the lines of interest.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_FILE_BLAME_USER_TITLE", "Get file blame"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path to the file"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit SHA to blame the file at (defaults to the default branch)"),
+			),
+			mcp.WithNumber("start_line",
+				mcp.Description("First line to include, 1-based (default: first line of the file)"),
+				mcp.Min(1),
+			),
+			mcp.WithNumber("end_line",
+				mcp.Description("Last line to include (default: last line of the file)"),
+				mcp.Min(1),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := RequiredParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			startLine, err := OptionalIntParam(request, "start_line")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			endLine, err := OptionalIntParam(request, "end_line")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if startLine > 0 && endLine > 0 && endLine < startLine {
+				return mcp.NewToolResultError("end_line must not be before start_line"), nil
+			}
+			if ref == "" {
+				ref = "HEAD"
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			var query FileBlameQuery
+			if err := client.Query(ctx, &query, map[string]any{
+				"owner": githubv4.String(owner),
+				"repo":  githubv4.String(repo),
+				"ref":   githubv4.String(ref),
+				"path":  githubv4.String(strings.TrimPrefix(path, "/")),
+			}); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get file blame", err), nil
+			}
+
+			commit := query.Repository.Object.Commit
+			if commit.Oid == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("ref %s does not resolve to a commit", ref)), nil
+			}
+
+			result := FileBlameResult{
+				Path:   path,
+				SHA:    string(commit.Oid),
+				Ranges: []BlameRange{},
+			}
+			for _, r := range commit.Blame.Ranges {
+				start, end := int(r.StartingLine), int(r.EndingLine)
+				if (startLine > 0 && end < startLine) || (endLine > 0 && start > endLine) {
+					continue
+				}
+				// Clip ranges overlapping the edges of the requested lines.
+				if startLine > start {
+					start = startLine
+				}
+				if endLine > 0 && endLine < end {
+					end = endLine
+				}
+				blameRange := BlameRange{
+					StartLine:   start,
+					EndLine:     end,
+					SHA:         string(r.Commit.Oid),
+					Author:      string(r.Commit.Author.Name),
+					AuthorLogin: string(r.Commit.Author.User.Login),
+					Message:     string(r.Commit.MessageHeadline),
+					URL:         r.Commit.URL.String(),
+				}
+				if !r.Commit.CommittedDate.IsZero() {
+					blameRange.Date = r.Commit.CommittedDate.Format("2006-01-02T15:04:05Z")
+				}
+				if len(r.Commit.AssociatedPullRequests.Nodes) > 0 {
+					blameRange.PullRequest = int(r.Commit.AssociatedPullRequests.Nodes[0].Number)
+				}
+				result.Ranges = append(result.Ranges, blameRange)
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
@@ -10,12 +10,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/github/github-mcp-http/internal/githubv4mock"
 	"github.com/github/github-mcp-http/internal/toolsnaps"
+	ghErrors "github.com/github/github-mcp-http/pkg/errors"
 	"github.com/github/github-mcp-http/pkg/raw"
 	"github.com/github/github-mcp-http/pkg/translations"
 	"github.com/google/go-github/v74/github"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -1336,6 +1339,8 @@ func Test_PushFiles(t *testing.T) {
 		expectError    bool
 		expectedRef    *github.Reference
 		expectedErrMsg string
+		// expectedCategory is the category of the structured error, when set.
+		expectedCategory ghErrors.Category
 	}{
 		{
 			name: "successful push of multiple files",
@@ -1572,6 +1577,306 @@ func Test_PushFiles(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to create tree",
 		},
+		{
+			name: "successful push of deletions, renames, modes and binary content",
+			mockedClient: mock.NewMockedHTTPClient(
+				// Get branch reference
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+				// Get commit
+				mock.WithRequestMatch(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockCommit,
+				),
+				// Get base tree to look up the renamed file
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitTreesByOwnerByRepoByTreeSha,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/git/trees/def456",
+						queryParams: map[string]string{"recursive": "1"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Tree{
+							SHA: github.Ptr("def456"),
+							Entries: []*github.TreeEntry{
+								{Path: github.Ptr("scripts"), Type: github.Ptr("tree"), Mode: github.Ptr("040000"), SHA: github.Ptr("tree1")},
+								{Path: github.Ptr("scripts/build.sh"), Type: github.Ptr("blob"), Mode: github.Ptr("100755"), SHA: github.Ptr("blob1")},
+							},
+						}),
+					),
+				),
+				// Create blob for binary content
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitBlobsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"content":  "iVBORw0KGgo=",
+						"encoding": "base64",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Blob{SHA: github.Ptr("blob2")}),
+					),
+				),
+				// Create tree
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitTreesByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"base_tree": "def456",
+						"tree": []interface{}{
+							map[string]interface{}{
+								"path": "old.md",
+								"mode": "100644",
+								"type": "blob",
+								"sha":  nil,
+							},
+							map[string]interface{}{
+								"path": "scripts/build.sh",
+								"mode": "100644",
+								"type": "blob",
+								"sha":  nil,
+							},
+							map[string]interface{}{
+								"path": "tools/build.sh",
+								"mode": "100755",
+								"type": "blob",
+								"sha":  "blob1",
+							},
+							map[string]interface{}{
+								"path": "logo.png",
+								"mode": "100644",
+								"type": "blob",
+								"sha":  "blob2",
+							},
+							map[string]interface{}{
+								"path":    "latest",
+								"mode":    "120000",
+								"type":    "blob",
+								"content": "releases/v1.2.0",
+							},
+						},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockTree),
+					),
+				),
+				// Create commit
+				mock.WithRequestMatch(
+					mock.PostReposGitCommitsByOwnerByRepo,
+					mockNewCommit,
+				),
+				// Update reference
+				mock.WithRequestMatch(
+					mock.PatchReposGitRefsByOwnerByRepoByRef,
+					mockUpdatedRef,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":      "old.md",
+						"operation": "delete",
+					},
+					map[string]interface{}{
+						"path":      "tools/build.sh",
+						"operation": "rename",
+						"from_path": "scripts/build.sh",
+					},
+					map[string]interface{}{
+						"path":      "logo.png",
+						"operation": "add",
+						"content":   "iVBORw0KGgo=",
+						"encoding":  "base64",
+					},
+					map[string]interface{}{
+						"path":    "latest",
+						"content": "releases/v1.2.0",
+						"mode":    "120000",
+					},
+				},
+				"message":  "Reorganise files",
+				"base_sha": "abc123",
+			},
+			expectError: false,
+			expectedRef: mockUpdatedRef,
+		},
+		{
+			name: "fails when renamed file does not exist",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockCommit,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposGitTreesByOwnerByRepoByTreeSha,
+					&github.Tree{SHA: github.Ptr("def456")},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":      "new.md",
+						"operation": "rename",
+						"from_path": "missing.md",
+					},
+				},
+				"message": "Rename file",
+			},
+			expectError:    true,
+			expectedErrMsg: "cannot rename missing.md: no such file on branch main",
+		},
+		{
+			name: "fails when the branch moved before pushing",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# README",
+					},
+				},
+				"message":  "Update file",
+				"base_sha": "old999",
+			},
+			expectError:      true,
+			expectedErrMsg:   "branch main has moved from old999 to abc123, no changes were pushed",
+			expectedCategory: ghErrors.CategoryConflict,
+		},
+		{
+			name: "fails when the branch moved while pushing",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+					&github.Reference{
+						Ref:    github.Ptr("refs/heads/main"),
+						Object: &github.GitObject{SHA: github.Ptr("xyz999")},
+					},
+				),
+				mock.WithRequestMatch(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockCommit,
+				),
+				mock.WithRequestMatch(
+					mock.PostReposGitTreesByOwnerByRepo,
+					mockTree,
+				),
+				mock.WithRequestMatch(
+					mock.PostReposGitCommitsByOwnerByRepo,
+					mockNewCommit,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposGitRefsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Update is not a fast forward"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# README",
+					},
+				},
+				"message": "Update file",
+			},
+			expectError:      true,
+			expectedErrMsg:   "branch main moved from abc123 to xyz999 while pushing, no changes were pushed",
+			expectedCategory: ghErrors.CategoryConflict,
+		},
+		{
+			name: "reports other update failures as API errors",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+					mockRef,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockCommit,
+				),
+				mock.WithRequestMatch(
+					mock.PostReposGitTreesByOwnerByRepo,
+					mockTree,
+				),
+				mock.WithRequestMatch(
+					mock.PostReposGitCommitsByOwnerByRepo,
+					mockNewCommit,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposGitRefsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Protected branch update failed for refs/heads/main."}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# README",
+					},
+				},
+				"message": "Update file",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update reference",
+		},
+		{
+			name:         "fails when operation is invalid",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":      "README.md",
+						"operation": "copy",
+					},
+				},
+				"message": "Copy file",
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid operation "copy" for README.md`,
+		},
+		{
+			name:         "fails when rename has no from_path",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":      "new.md",
+						"operation": "rename",
+					},
+				},
+				"message": "Rename file",
+			},
+			expectError:    true,
+			expectedErrMsg: "from_path is required to rename new.md",
+		},
 	}
 
 	for _, tc := range tests {
@@ -1592,6 +1897,10 @@ func Test_PushFiles(t *testing.T) {
 				require.True(t, result.IsError)
 				errorContent := getErrorResult(t, result)
 				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				if tc.expectedCategory != "" {
+					toolErr := result.StructuredContent.(map[string]any)["error"].(ghErrors.ToolError)
+					assert.Equal(t, tc.expectedCategory, toolErr.Category)
+				}
 				return
 			}
 
@@ -3192,3 +3501,630 @@ func Test_UnstarRepository(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetRepository(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_repository", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockRepo := &github.Repository{
+		ID:                  github.Ptr(int64(1)),
+		Name:                github.Ptr("repo"),
+		FullName:            github.Ptr("owner/repo"),
+		Homepage:            github.Ptr("https://example.com"),
+		Visibility:          github.Ptr("private"),
+		Private:             github.Ptr(true),
+		DefaultBranch:       github.Ptr("main"),
+		Topics:              []string{"mcp"},
+		HasIssues:           github.Ptr(true),
+		HasWiki:             github.Ptr(false),
+		AllowSquashMerge:    github.Ptr(true),
+		DeleteBranchOnMerge: github.Ptr(true),
+		License:             &github.License{SPDXID: github.Ptr("MIT")},
+		Permissions:         map[string]bool{"admin": false, "maintain": false, "push": true, "triage": true, "pull": true},
+	}
+	codeowners := "# Owners\n* @owner/maintainers\n/docs/ @octocat @owner/docs # docs team\n\n*.go @owner/maintainers\n"
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		expectError        bool
+		expectedErrMsg     string
+		expectedLanguages  map[string]float64
+		expectedCodeowners *CodeownersSummary
+	}{
+		{
+			name: "repository with CODEOWNERS",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposByOwnerByRepo, mockRepo),
+				mock.WithRequestMatch(mock.GetReposLanguagesByOwnerByRepo, map[string]int{"Go": 750, "Shell": 250}),
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.URL.Path != "/repos/owner/repo/contents/CODEOWNERS" {
+							mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"})(w, r)
+							return
+						}
+						mockResponse(t, http.StatusOK, &github.RepositoryContent{
+							Type:     github.Ptr("file"),
+							Path:     github.Ptr("CODEOWNERS"),
+							Encoding: github.Ptr("base64"),
+							Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(codeowners))),
+						})(w, r)
+					}),
+				),
+			),
+			expectedLanguages: map[string]float64{"Go": 75, "Shell": 25},
+			expectedCodeowners: &CodeownersSummary{
+				Path:      "CODEOWNERS",
+				RuleCount: 3,
+				Owners:    []string{"@owner/maintainers", "@octocat", "@owner/docs"},
+				Rules: []CodeownersRule{
+					{Pattern: "*", Owners: []string{"@owner/maintainers"}},
+					{Pattern: "/docs/", Owners: []string{"@octocat", "@owner/docs"}},
+					{Pattern: "*.go", Owners: []string{"@owner/maintainers"}},
+				},
+			},
+		},
+		{
+			name: "repository without CODEOWNERS",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposByOwnerByRepo, mockRepo),
+				mock.WithRequestMatch(mock.GetReposLanguagesByOwnerByRepo, map[string]int{"Go": 750, "Shell": 250}),
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectedLanguages: map[string]float64{"Go": 75, "Shell": 25},
+		},
+		{
+			name: "empty repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposByOwnerByRepo, mockRepo),
+				mock.WithRequestMatch(mock.GetReposLanguagesByOwnerByRepo, map[string]int{}),
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "This repository is empty."}),
+				),
+			),
+			expectedLanguages: map[string]float64{},
+		},
+		{
+			name: "CODEOWNERS read fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposByOwnerByRepo, mockRepo),
+				mock.WithRequestMatch(mock.GetReposLanguagesByOwnerByRepo, map[string]int{"Go": 750, "Shell": 250}),
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by integration"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get .github/CODEOWNERS",
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned RepositoryDetails
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, "owner/repo", returned.FullName)
+			assert.Equal(t, "private", returned.Visibility)
+			assert.Equal(t, "MIT", returned.License)
+			assert.Equal(t, "push", returned.MyPermission)
+			assert.Equal(t, []string{"mcp"}, returned.Topics)
+			assert.True(t, returned.Features.Issues)
+			assert.False(t, returned.Features.Wiki)
+			assert.True(t, returned.MergeSettings.AllowSquashMerge)
+			assert.True(t, returned.MergeSettings.DeleteBranchOnMerge)
+			assert.Equal(t, tc.expectedLanguages, returned.Languages)
+			assert.Equal(t, tc.expectedCodeowners, returned.Codeowners)
+		})
+	}
+}
+
+func Test_UpdateRepository(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_repository", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.InputSchema.Properties, "topics")
+	assert.Contains(t, tool.InputSchema.Properties, "archived")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	t.Run("settings, topics and archiving", func(t *testing.T) {
+		var requests []string
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.PatchReposByOwnerByRepo,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					var body map[string]any
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					if _, ok := body["archived"]; ok {
+						requests = append(requests, "archive")
+						assert.Equal(t, map[string]any{"archived": true}, body)
+					} else {
+						requests = append(requests, "edit")
+						assert.Equal(t, map[string]any{
+							"description":        "",
+							"visibility":         "public",
+							"has_wiki":           false,
+							"allow_squash_merge": true,
+						}, body)
+					}
+					mockResponse(t, http.StatusOK, &github.Repository{Name: github.Ptr("repo")})(w, r)
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PutReposTopicsByOwnerByRepo,
+				expectRequestBody(t, map[string]any{"names": []any{}}).andThen(
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						requests = append(requests, "topics")
+						mockResponse(t, http.StatusOK, map[string]any{"names": []string{}})(w, r)
+					}),
+				),
+			),
+			mock.WithRequestMatch(
+				mock.GetReposByOwnerByRepo,
+				&github.Repository{Name: github.Ptr("repo"), FullName: github.Ptr("owner/repo"), Archived: github.Ptr(true), Visibility: github.Ptr("public")},
+			),
+		))
+		_, handler := UpdateRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":              "owner",
+			"repo":               "repo",
+			"description":        "",
+			"visibility":         "public",
+			"has_wiki":           false,
+			"allow_squash_merge": true,
+			"topics":             []interface{}{},
+			"archived":           true,
+		}))
+		require.NoError(t, err)
+
+		textContent := getTextResult(t, result)
+		var returned RepositoryDetails
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+		assert.True(t, returned.Archived)
+		assert.Equal(t, []string{"edit", "topics", "archive"}, requests)
+	})
+
+	t.Run("unarchive before renaming", func(t *testing.T) {
+		var requests []string
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.PatchReposByOwnerByRepo,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					var body map[string]any
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					requests = append(requests, r.URL.Path)
+					if len(requests) == 1 {
+						assert.Equal(t, map[string]any{"archived": false}, body)
+						mockResponse(t, http.StatusOK, &github.Repository{Name: github.Ptr("repo")})(w, r)
+						return
+					}
+					assert.Equal(t, map[string]any{"name": "renamed"}, body)
+					mockResponse(t, http.StatusOK, &github.Repository{Name: github.Ptr("renamed")})(w, r)
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.GetReposByOwnerByRepo,
+				expectPath(t, "/repos/owner/renamed").andThen(
+					mockResponse(t, http.StatusOK, &github.Repository{Name: github.Ptr("renamed"), FullName: github.Ptr("owner/renamed")}),
+				),
+			),
+		))
+		_, handler := UpdateRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":    "owner",
+			"repo":     "repo",
+			"name":     "renamed",
+			"archived": false,
+		}))
+		require.NoError(t, err)
+
+		textContent := getTextResult(t, result)
+		var returned RepositoryDetails
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+		assert.Equal(t, "owner/renamed", returned.FullName)
+		assert.Equal(t, []string{"/repos/owner/repo", "/repos/owner/repo"}, requests)
+	})
+
+	t.Run("no update parameters", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient())
+		_, handler := UpdateRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner": "owner",
+			"repo":  "repo",
+		}))
+		require.NoError(t, err)
+
+		errorContent := getErrorResult(t, result)
+		assert.Equal(t, "No update parameters provided.", errorContent.Text)
+	})
+}
+
+func Test_CompareRefs(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CompareRefs(stubGetClientFn(mockClient), translations.NullTranslationHelper, 5000)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "compare_refs", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "include_patches")
+	assert.Contains(t, tool.InputSchema.Properties, "patch_offset")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "base", "head"})
+
+	comparison := &github.CommitsComparison{
+		Status:       github.Ptr("diverged"),
+		AheadBy:      github.Ptr(2),
+		BehindBy:     github.Ptr(1),
+		TotalCommits: github.Ptr(2),
+		MergeBaseCommit: &github.RepositoryCommit{
+			SHA:    github.Ptr("base123"),
+			Commit: &github.Commit{Message: github.Ptr("Release v1.2.0")},
+		},
+		Commits: []*github.RepositoryCommit{
+			{SHA: github.Ptr("abc123"), Commit: &github.Commit{Message: github.Ptr("First change")}},
+			{SHA: github.Ptr("def456"), Commit: &github.Commit{Message: github.Ptr("Second change")}},
+		},
+		Files: []*github.CommitFile{
+			{Filename: github.Ptr("a.go"), Status: github.Ptr("modified"), Additions: github.Ptr(1), Deletions: github.Ptr(1), Changes: github.Ptr(2), Patch: github.Ptr("@@ -1 +1 @@\n-old\n+new")},
+			{Filename: github.Ptr("b.go"), Status: github.Ptr("added"), Additions: github.Ptr(2), Changes: github.Ptr(2), Patch: github.Ptr("@@ -0,0 +1,2 @@\n+one\n+two")},
+			{Filename: github.Ptr("logo.png"), Status: github.Ptr("added")},
+			{Filename: github.Ptr("c.go"), Status: github.Ptr("removed"), Deletions: github.Ptr(1), Changes: github.Ptr(1), Patch: github.Ptr("@@ -1 +0,0 @@\n-gone")},
+		},
+	}
+
+	tests := []struct {
+		name                    string
+		args                    map[string]interface{}
+		contentWindowSize       int
+		expectedPatches         []FilePatch
+		expectedNextPatchOffset int
+	}{
+		{
+			name: "without patches",
+			args: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "v1.2.0",
+				"head":  "main",
+			},
+			contentWindowSize: 5000,
+		},
+		{
+			name: "patches fitting the content window",
+			args: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"base":            "v1.2.0",
+				"head":            "main",
+				"include_patches": true,
+			},
+			contentWindowSize: 5000,
+			expectedPatches: []FilePatch{
+				{Filename: "a.go", Patch: "@@ -1 +1 @@\n-old\n+new"},
+				{Filename: "b.go", Patch: "@@ -0,0 +1,2 @@\n+one\n+two"},
+				{Filename: "c.go", Patch: "@@ -1 +0,0 @@\n-gone"},
+			},
+		},
+		{
+			name: "patches paged by the content window",
+			args: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"base":            "v1.2.0",
+				"head":            "main",
+				"include_patches": true,
+			},
+			contentWindowSize: 5,
+			expectedPatches: []FilePatch{
+				{Filename: "a.go", Patch: "@@ -1 +1 @@\n-old\n+new"},
+			},
+			expectedNextPatchOffset: 1,
+		},
+		{
+			name: "last page of patches",
+			args: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"base":            "v1.2.0",
+				"head":            "main",
+				"include_patches": true,
+				"patch_offset":    float64(1),
+			},
+			contentWindowSize: 5,
+			expectedPatches: []FilePatch{
+				{Filename: "b.go", Patch: "@@ -0,0 +1,2 @@\n+one\n+two"},
+				{Filename: "c.go", Patch: "@@ -1 +0,0 @@\n-gone"},
+			},
+		},
+		{
+			name: "patch longer than the content window",
+			args: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"base":            "v1.2.0",
+				"head":            "main",
+				"include_patches": true,
+				"patch_offset":    float64(1),
+			},
+			contentWindowSize: 2,
+			expectedPatches: []FilePatch{
+				{Filename: "b.go", Patch: "@@ -0,0 +1,2 @@\n+one", Truncated: true},
+			},
+			expectedNextPatchOffset: 2,
+		},
+		{
+			name: "content window below one line",
+			args: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"base":            "v1.2.0",
+				"head":            "main",
+				"include_patches": true,
+			},
+			contentWindowSize: -1,
+			expectedPatches: []FilePatch{
+				{Filename: "a.go", Patch: "@@ -1 +1 @@", Truncated: true},
+			},
+			expectedNextPatchOffset: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					expectPath(t, "/repos/owner/repo/compare/v1.2.0...main").andThen(
+						mockResponse(t, http.StatusOK, comparison),
+					),
+				),
+			))
+			_, handler := CompareRefs(stubGetClientFn(client), translations.NullTranslationHelper, tc.contentWindowSize)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			var returned CompareRefsResult
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+
+			assert.Equal(t, "diverged", returned.Status)
+			assert.Equal(t, 2, returned.AheadBy)
+			assert.Equal(t, 1, returned.BehindBy)
+			require.NotNil(t, returned.MergeBase)
+			assert.Equal(t, "base123", returned.MergeBase.SHA)
+			require.Len(t, returned.Commits, 2)
+			assert.Equal(t, "First change", returned.Commits[0].Commit.Message)
+			require.Len(t, returned.Files, 4)
+			assert.Equal(t, MinimalCommitFile{Filename: "a.go", Status: "modified", Additions: 1, Deletions: 1, Changes: 2}, returned.Files[0])
+			assert.Equal(t, tc.expectedPatches, returned.Patches)
+			assert.Equal(t, tc.expectedNextPatchOffset, returned.NextPatchOffset)
+		})
+	}
+}
+
+func Test_GetFileBlame(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := GetFileBlame(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_file_blame", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "start_line")
+	assert.Contains(t, tool.InputSchema.Properties, "end_line")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "path"})
+
+	blameRange := func(start, end int, sha, message string, pullRequests ...int) map[string]any {
+		nodes := []any{}
+		for _, number := range pullRequests {
+			nodes = append(nodes, map[string]any{"number": number})
+		}
+		return map[string]any{
+			"startingLine": start,
+			"endingLine":   end,
+			"commit": map[string]any{
+				"oid":             sha,
+				"messageHeadline": message,
+				"committedDate":   "2025-01-02T03:04:05Z",
+				"url":             "https://github.com/owner/repo/commit/" + sha,
+				"author": map[string]any{
+					"name": "The Octocat",
+					"user": map[string]any{"login": "octocat"},
+				},
+				"associatedPullRequests": map[string]any{"nodes": nodes},
+			},
+		}
+	}
+	blameResponse := githubv4mock.DataResponse(map[string]any{
+		"repository": map[string]any{
+			"object": map[string]any{
+				"oid": "head123",
+				"blame": map[string]any{
+					"ranges": []any{
+						blameRange(1, 4, "abc123", "Initial commit"),
+						blameRange(5, 9, "def456", "Fix the parser", 42),
+						blameRange(10, 12, "abc123", "Initial commit"),
+					},
+				},
+			},
+		},
+	})
+
+	tests := []struct {
+		name           string
+		requestArgs    map[string]any
+		vars           map[string]any
+		response       githubv4mock.GQLResponse
+		expectError    bool
+		expectedErrMsg string
+		expected       FileBlameResult
+	}{
+		{
+			name: "whole file at the default branch",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "src/parser.go",
+			},
+			vars: map[string]any{
+				"owner": githubv4.String("owner"),
+				"repo":  githubv4.String("repo"),
+				"ref":   githubv4.String("HEAD"),
+				"path":  githubv4.String("src/parser.go"),
+			},
+			response: blameResponse,
+			expected: FileBlameResult{
+				Path: "src/parser.go",
+				SHA:  "head123",
+				Ranges: []BlameRange{
+					{StartLine: 1, EndLine: 4, SHA: "abc123", Author: "The Octocat", AuthorLogin: "octocat", Date: "2025-01-02T03:04:05Z", Message: "Initial commit", URL: "https://github.com/owner/repo/commit/abc123"},
+					{StartLine: 5, EndLine: 9, SHA: "def456", Author: "The Octocat", AuthorLogin: "octocat", Date: "2025-01-02T03:04:05Z", Message: "Fix the parser", URL: "https://github.com/owner/repo/commit/def456", PullRequest: 42},
+					{StartLine: 10, EndLine: 12, SHA: "abc123", Author: "The Octocat", AuthorLogin: "octocat", Date: "2025-01-02T03:04:05Z", Message: "Initial commit", URL: "https://github.com/owner/repo/commit/abc123"},
+				},
+			},
+		},
+		{
+			name: "line range at a ref",
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"path":       "src/parser.go",
+				"ref":        "v1.2.0",
+				"start_line": float64(3),
+				"end_line":   float64(6),
+			},
+			vars: map[string]any{
+				"owner": githubv4.String("owner"),
+				"repo":  githubv4.String("repo"),
+				"ref":   githubv4.String("v1.2.0"),
+				"path":  githubv4.String("src/parser.go"),
+			},
+			response: blameResponse,
+			expected: FileBlameResult{
+				Path: "src/parser.go",
+				SHA:  "head123",
+				Ranges: []BlameRange{
+					{StartLine: 3, EndLine: 4, SHA: "abc123", Author: "The Octocat", AuthorLogin: "octocat", Date: "2025-01-02T03:04:05Z", Message: "Initial commit", URL: "https://github.com/owner/repo/commit/abc123"},
+					{StartLine: 5, EndLine: 6, SHA: "def456", Author: "The Octocat", AuthorLogin: "octocat", Date: "2025-01-02T03:04:05Z", Message: "Fix the parser", URL: "https://github.com/owner/repo/commit/def456", PullRequest: 42},
+				},
+			},
+		},
+		{
+			name: "ref not found",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "src/parser.go",
+				"ref":   "missing",
+			},
+			vars: map[string]any{
+				"owner": githubv4.String("owner"),
+				"repo":  githubv4.String("repo"),
+				"ref":   githubv4.String("missing"),
+				"path":  githubv4.String("src/parser.go"),
+			},
+			response:       githubv4mock.DataResponse(map[string]any{"repository": map[string]any{"object": nil}}),
+			expectError:    true,
+			expectedErrMsg: "ref missing does not resolve to a commit",
+		},
+		{
+			name: "file not found",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "missing.go",
+			},
+			vars: map[string]any{
+				"owner": githubv4.String("owner"),
+				"repo":  githubv4.String("repo"),
+				"ref":   githubv4.String("HEAD"),
+				"path":  githubv4.String("missing.go"),
+			},
+			response:       githubv4mock.ErrorResponse("Could not resolve file for path 'missing.go'."),
+			expectError:    true,
+			expectedErrMsg: "failed to get file blame",
+		},
+		{
+			name: "end line before start line",
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"path":       "src/parser.go",
+				"start_line": float64(6),
+				"end_line":   float64(3),
+			},
+			expectError:    true,
+			expectedErrMsg: "end_line must not be before start_line",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockedClient := githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(FileBlameQuery{}, tc.vars, tc.response),
+			)
+			_, handler := GetFileBlame(stubGetGQLClientFn(githubv4.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned FileBlameResult
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
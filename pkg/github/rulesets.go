@@ -0,0 +1,624 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	ghErrors "github.com/github/github-mcp-http/pkg/errors"
+	"github.com/github/github-mcp-http/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// actorNames lists users, teams and apps as "user:login", "team:slug" and "app:slug".
+func actorNames(users []*github.User, teams []*github.Team, apps []*github.App) []string {
+	var names []string
+	for _, user := range users {
+		names = append(names, "user:"+user.GetLogin())
+	}
+	for _, team := range teams {
+		names = append(names, "team:"+team.GetSlug())
+	}
+	for _, app := range apps {
+		names = append(names, "app:"+app.GetSlug())
+	}
+	return names
+}
+
+func convertToMinimalBranchProtection(branch string, protection *github.Protection) MinimalBranchProtection {
+	minimal := MinimalBranchProtection{
+		Branch:             branch,
+		Protected:          true,
+		RequiredSignatures: protection.GetRequiredSignatures().GetEnabled(),
+		LockBranch:         protection.GetLockBranch().GetEnabled(),
+	}
+	// These settings are omitted from the response when the protection doesn't configure them.
+	if protection.EnforceAdmins != nil {
+		minimal.EnforceAdmins = protection.EnforceAdmins.Enabled
+	}
+	if protection.RequireLinearHistory != nil {
+		minimal.RequiredLinearHistory = protection.RequireLinearHistory.Enabled
+	}
+	if protection.RequiredConversationResolution != nil {
+		minimal.RequiredConversationResolution = protection.RequiredConversationResolution.Enabled
+	}
+	if protection.AllowForcePushes != nil {
+		minimal.AllowForcePushes = protection.AllowForcePushes.Enabled
+	}
+	if protection.AllowDeletions != nil {
+		minimal.AllowDeletions = protection.AllowDeletions.Enabled
+	}
+	if checks := protection.GetRequiredStatusChecks(); checks != nil {
+		minimal.RequiredStatusChecks = &MinimalRequiredStatusChecks{Strict: checks.Strict, Checks: []string{}}
+		if checks.Checks != nil {
+			for _, check := range *checks.Checks {
+				minimal.RequiredStatusChecks.Checks = append(minimal.RequiredStatusChecks.Checks, check.Context)
+			}
+		} else if checks.Contexts != nil {
+			minimal.RequiredStatusChecks.Checks = append(minimal.RequiredStatusChecks.Checks, *checks.Contexts...)
+		}
+	}
+	if reviews := protection.GetRequiredPullRequestReviews(); reviews != nil {
+		minimal.RequiredPullRequestReviews = &MinimalRequiredReviews{
+			RequiredApprovingReviewCount: reviews.RequiredApprovingReviewCount,
+			DismissStaleReviews:          reviews.DismissStaleReviews,
+			RequireCodeOwnerReviews:      reviews.RequireCodeOwnerReviews,
+			RequireLastPushApproval:      reviews.RequireLastPushApproval,
+		}
+		if allowances := reviews.BypassPullRequestAllowances; allowances != nil {
+			minimal.RequiredPullRequestReviews.BypassAllowances = actorNames(allowances.Users, allowances.Teams, allowances.Apps)
+		}
+	}
+	if restrictions := protection.GetRestrictions(); restrictions != nil {
+		minimal.PushRestrictedTo = actorNames(restrictions.Users, restrictions.Teams, restrictions.Apps)
+	}
+	return minimal
+}
+
+// GetBranchProtection creates a tool to get the classic branch protection of a branch.
+func GetBranchProtection(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_branch_protection",
+			mcp.WithDescription(t("TOOL_GET_BRANCH_PROTECTION_DESCRIPTION", "Get the classic branch protection of a branch: required status checks and reviews, who may bypass them or push, and whether force pushes and deletions are allowed. Rulesets can protect a branch too, use get_branch_rules to see them.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_BRANCH_PROTECTION_USER_TITLE", "Get branch protection"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := RequiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			protection, resp, err := client.Repositories.GetBranchProtection(ctx, owner, repo, branch)
+			if errors.Is(err, github.ErrBranchNotProtected) {
+				return MarshalledTextResult(MinimalBranchProtection{Branch: branch}), nil
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get branch protection", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(convertToMinimalBranchProtection(branch, protection)), nil
+		}
+}
+
+// EffectiveBranchRule is a rule that applies to a branch, and the ruleset it comes from.
+type EffectiveBranchRule struct {
+	Type              string          `json:"type"`
+	RulesetID         int64           `json:"ruleset_id"`
+	RulesetSource     string          `json:"ruleset_source"`
+	RulesetSourceType string          `json:"ruleset_source_type"`
+	Parameters        json.RawMessage `json:"parameters,omitempty"`
+}
+
+// BranchRulesResult is the output type of get_branch_rules.
+type BranchRulesResult struct {
+	Branch   string                `json:"branch"`
+	Rules    []EffectiveBranchRule `json:"rules"`
+	Rulesets []BranchRuleset       `json:"rulesets"`
+	Notes    []string              `json:"notes,omitempty"`
+}
+
+// BranchRuleset is a ruleset that applies to a branch, with who may bypass it.
+type BranchRuleset struct {
+	MinimalRuleset
+	BypassActors         []*github.BypassActor `json:"bypass_actors,omitempty"`
+	CurrentUserCanBypass string                `json:"current_user_can_bypass,omitempty"`
+}
+
+// GetBranchRules creates a tool to get the rules that apply to a branch from all active rulesets.
+func GetBranchRules(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_branch_rules",
+			mcp.WithDescription(t("TOOL_GET_BRANCH_RULES_DESCRIPTION", "Get the rules that apply to a branch from every active ruleset of the repository and its organization, such as required status checks, pull request reviews and blocked force pushes, with the rulesets they come from and who may bypass them. Bypass actors are only visible to repository admins.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_BRANCH_RULES_USER_TITLE", "Get rules for branch"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := RequiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// The rules are requested directly, as go-github groups them by type and drops their parameters' raw form.
+			httpRequest, err := client.NewRequest("GET", fmt.Sprintf("repos/%s/%s/rules/branches/%s?per_page=100", owner, repo, url.PathEscape(branch)), nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			result := BranchRulesResult{Branch: branch, Rules: []EffectiveBranchRule{}, Rulesets: []BranchRuleset{}}
+			resp, err := client.Do(ctx, httpRequest, &result.Rules)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get branch rules", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			seen := map[int64]bool{}
+			for _, rule := range result.Rules {
+				if seen[rule.RulesetID] {
+					continue
+				}
+				seen[rule.RulesetID] = true
+
+				ruleset, resp, err := client.Repositories.GetRuleset(ctx, owner, repo, rule.RulesetID, true)
+				if err != nil {
+					if resp != nil && (resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusNotFound) {
+						result.Rulesets = append(result.Rulesets, BranchRuleset{MinimalRuleset: MinimalRuleset{
+							ID:     rule.RulesetID,
+							Source: rule.RulesetSource,
+						}})
+						result.Notes = append(result.Notes, fmt.Sprintf("ruleset %d could not be read with this token, so its bypass actors are unknown", rule.RulesetID))
+						continue
+					}
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get ruleset", resp, err), nil
+				}
+				_ = resp.Body.Close()
+
+				branchRuleset := BranchRuleset{
+					MinimalRuleset: convertToMinimalRuleset(ruleset),
+					BypassActors:   ruleset.BypassActors,
+				}
+				if ruleset.CurrentUserCanBypass != nil {
+					branchRuleset.CurrentUserCanBypass = string(*ruleset.CurrentUserCanBypass)
+				}
+				result.Rulesets = append(result.Rulesets, branchRuleset)
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// ListRepositoryRulesets creates a tool to list the rulesets of a repository.
+func ListRepositoryRulesets(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_rulesets",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_RULESETS_DESCRIPTION", "List the rulesets of a repository, including those inherited from its organization.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REPOSITORY_RULESETS_USER_TITLE", "List repository rulesets"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithBoolean("includes_parents",
+				mcp.Description("Include rulesets configured at the organization level (default: true)"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includesParents, provided, err := OptionalParamOK[bool](request, "includes_parents")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !provided {
+				includesParents = true
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			rulesets, resp, err := client.Repositories.GetAllRulesets(ctx, owner, repo, &github.RepositoryListRulesetsOptions{
+				IncludesParents: github.Ptr(includesParents),
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list rulesets", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			minimalRulesets := make([]MinimalRuleset, len(rulesets))
+			for i, ruleset := range rulesets {
+				minimalRulesets[i] = convertToMinimalRuleset(ruleset)
+			}
+			return MarshalledTextResult(minimalRulesets), nil
+		}
+}
+
+// GetRepositoryRuleset creates a tool to get a ruleset of a repository with its conditions, rules and bypass actors.
+func GetRepositoryRuleset(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_ruleset",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_RULESET_DESCRIPTION", "Get a ruleset of a repository with the refs it targets, its rules and who may bypass it.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPOSITORY_RULESET_USER_TITLE", "Get repository ruleset"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("ruleset_id",
+				mcp.Required(),
+				mcp.Description("The ID of the ruleset"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			rulesetID, err := RequiredInt(request, "ruleset_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			ruleset, resp, err := client.Repositories.GetRuleset(ctx, owner, repo, int64(rulesetID), true)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get ruleset", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(ruleset), nil
+		}
+}
+
+// withRulesetFields adds the parameters of a ruleset shared by create_repository_ruleset and update_repository_ruleset.
+func withRulesetFields() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("target",
+			mcp.Description("What the ruleset applies to (default: branch)"),
+			mcp.Enum("branch", "tag", "push"),
+		)(tool)
+		mcp.WithArray("include_refs",
+			mcp.Description("Ref patterns the ruleset applies to, e.g. refs/heads/main, refs/heads/release/*, ~DEFAULT_BRANCH or ~ALL"),
+			mcp.Items(map[string]any{"type": "string"}),
+		)(tool)
+		mcp.WithArray("exclude_refs",
+			mcp.Description("Ref patterns excluded from the ruleset"),
+			mcp.Items(map[string]any{"type": "string"}),
+		)(tool)
+		mcp.WithArray("rules",
+			mcp.Description("Rules in the format of the REST API, e.g. [{\"type\": \"deletion\"}, {\"type\": \"pull_request\", \"parameters\": {\"required_approving_review_count\": 1, ...}}, {\"type\": \"required_status_checks\", \"parameters\": {\"required_status_checks\": [{\"context\": \"ci\"}], \"strict_required_status_checks_policy\": false}}]"),
+			mcp.Items(map[string]any{"type": "object"}),
+		)(tool)
+		mcp.WithArray("bypass_actors",
+			mcp.Description("Actors that may bypass the ruleset, e.g. [{\"actor_id\": 5, \"actor_type\": \"RepositoryRole\", \"bypass_mode\": \"always\"}]. Actor types are Integration, OrganizationAdmin, RepositoryRole, Team and DeployKey. An empty array removes every bypass actor"),
+			mcp.Items(map[string]any{"type": "object"}),
+		)(tool)
+	}
+}
+
+// rulesetFields applies the parameters added by withRulesetFields to a ruleset.
+// It reports whether any of them were provided, and whether bypass_actors was provided as an empty array.
+func rulesetFields(request mcp.CallToolRequest, ruleset *github.RepositoryRuleset) (bool, bool, error) {
+	provided := false
+	clearBypassActors := false
+
+	if name, err := OptionalParam[string](request, "name"); err != nil {
+		return false, false, err
+	} else if name != "" {
+		ruleset.Name = name
+		provided = true
+	}
+	if enforcement, err := OptionalParam[string](request, "enforcement"); err != nil {
+		return false, false, err
+	} else if enforcement != "" {
+		ruleset.Enforcement = github.RulesetEnforcement(enforcement)
+		provided = true
+	}
+	if target, err := OptionalParam[string](request, "target"); err != nil {
+		return false, false, err
+	} else if target != "" {
+		ruleset.Target = github.Ptr(github.RulesetTarget(target))
+		provided = true
+	}
+
+	include, includeProvided, err := optionalStringArrayParamOK(request, "include_refs")
+	if err != nil {
+		return false, false, err
+	}
+	exclude, excludeProvided, err := optionalStringArrayParamOK(request, "exclude_refs")
+	if err != nil {
+		return false, false, err
+	}
+	if includeProvided || excludeProvided {
+		if ruleset.Conditions == nil {
+			ruleset.Conditions = &github.RepositoryRulesetConditions{}
+		}
+		if ruleset.Conditions.RefName == nil {
+			ruleset.Conditions.RefName = &github.RepositoryRulesetRefConditionParameters{Include: []string{}, Exclude: []string{}}
+		}
+		if includeProvided {
+			ruleset.Conditions.RefName.Include = include
+		}
+		if excludeProvided {
+			ruleset.Conditions.RefName.Exclude = exclude
+		}
+		provided = true
+	}
+
+	if rawRules, ok := request.GetArguments()["rules"]; ok {
+		var rules github.RepositoryRulesetRules
+		if err := remarshal(rawRules, &rules); err != nil {
+			return false, false, fmt.Errorf("invalid rules: %w", err)
+		}
+		ruleset.Rules = &rules
+		provided = true
+	}
+	if rawBypassActors, ok := request.GetArguments()["bypass_actors"]; ok {
+		var bypassActors []*github.BypassActor
+		if err := remarshal(rawBypassActors, &bypassActors); err != nil {
+			return false, false, fmt.Errorf("invalid bypass_actors: %w", err)
+		}
+		ruleset.BypassActors = bypassActors
+		clearBypassActors = len(bypassActors) == 0
+		provided = true
+	}
+
+	return provided, clearBypassActors, nil
+}
+
+// optionalStringArrayParamOK is OptionalStringArrayParam, also reporting whether the parameter was provided at all.
+func optionalStringArrayParamOK(request mcp.CallToolRequest, name string) ([]string, bool, error) {
+	if _, ok := request.GetArguments()[name]; !ok {
+		return nil, false, nil
+	}
+	values, err := OptionalStringArrayParam(request, name)
+	if values == nil {
+		values = []string{}
+	}
+	return values, true, err
+}
+
+// remarshal converts a decoded JSON tool argument into a typed value through its JSON form.
+func remarshal(value any, target any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
+
+// CreateRepositoryRuleset creates a tool to create a ruleset in a repository.
+func CreateRepositoryRuleset(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("create_repository_ruleset",
+			mcp.WithDescription(t("TOOL_CREATE_REPOSITORY_RULESET_DESCRIPTION", "Create a ruleset in a repository. Requires admin access to the repository. Use the evaluate enforcement to try a ruleset out without blocking anyone.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_REPOSITORY_RULESET_USER_TITLE", "Create repository ruleset"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Name of the ruleset"),
+			),
+			mcp.WithString("enforcement",
+				mcp.Required(),
+				mcp.Description("Whether the ruleset is enforced, only evaluated, or disabled"),
+				mcp.Enum("active", "evaluate", "disabled"),
+			),
+			withRulesetFields(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if _, err := RequiredParam[string](request, "name"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if _, err := RequiredParam[string](request, "enforcement"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			ruleset := github.RepositoryRuleset{Target: github.Ptr(github.RulesetTargetBranch)}
+			if _, _, err := rulesetFields(request, &ruleset); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			created, resp, err := client.Repositories.CreateRuleset(ctx, owner, repo, ruleset)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create ruleset", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(created), nil
+		}
+}
+
+// UpdateRepositoryRuleset creates a tool to update a ruleset of a repository.
+func UpdateRepositoryRuleset(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("update_repository_ruleset",
+			mcp.WithDescription(t("TOOL_UPDATE_REPOSITORY_RULESET_DESCRIPTION", "Update a ruleset of a repository. Only the provided fields change; rules, include_refs, exclude_refs and bypass_actors replace the current values as a whole. Requires admin access to the repository.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_REPOSITORY_RULESET_USER_TITLE", "Update repository ruleset"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("ruleset_id",
+				mcp.Required(),
+				mcp.Description("The ID of the ruleset"),
+			),
+			mcp.WithString("name",
+				mcp.Description("New name of the ruleset"),
+			),
+			mcp.WithString("enforcement",
+				mcp.Description("Whether the ruleset is enforced, only evaluated, or disabled"),
+				mcp.Enum("active", "evaluate", "disabled"),
+			),
+			withRulesetFields(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			rulesetID, err := RequiredInt(request, "ruleset_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// The API replaces the whole ruleset, so start from the current one.
+			ruleset, resp, err := client.Repositories.GetRuleset(ctx, owner, repo, int64(rulesetID), false)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get ruleset", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			provided, clearBypassActors, err := rulesetFields(request, ruleset)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !provided {
+				return mcp.NewToolResultError("No update parameters provided."), nil
+			}
+
+			if clearBypassActors {
+				// An empty bypass_actors list is dropped from the request body, so it has to be cleared separately.
+				resp, err := client.Repositories.UpdateRulesetClearBypassActor(ctx, owner, repo, int64(rulesetID))
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to remove bypass actors", resp, err), nil
+				}
+				_ = resp.Body.Close()
+			}
+
+			updated, resp, err := client.Repositories.UpdateRuleset(ctx, owner, repo, int64(rulesetID), *ruleset)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to update ruleset", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(updated), nil
+		}
+}
@@ -0,0 +1,614 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-http/internal/toolsnaps"
+	"github.com/github/github-mcp-http/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetBranchProtection(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetBranchProtection(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_branch_protection", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	mockProtection := &github.Protection{
+		RequiredStatusChecks: &github.RequiredStatusChecks{
+			Strict: true,
+			Checks: &[]*github.RequiredStatusCheck{{Context: "build"}, {Context: "test"}},
+		},
+		RequiredPullRequestReviews: &github.PullRequestReviewsEnforcement{
+			RequiredApprovingReviewCount: 2,
+			RequireCodeOwnerReviews:      true,
+			BypassPullRequestAllowances: &github.BypassPullRequestAllowances{
+				Users: []*github.User{{Login: github.Ptr("octocat")}},
+				Teams: []*github.Team{{Slug: github.Ptr("maintainers")}},
+			},
+		},
+		EnforceAdmins: &github.AdminEnforcement{Enabled: true},
+		Restrictions: &github.BranchRestrictions{
+			Apps: []*github.App{{Slug: github.Ptr("release-bot")}},
+		},
+		AllowForcePushes: &github.AllowForcePushes{Enabled: false},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedResult MinimalBranchProtection
+		expectedErrMsg string
+	}{
+		{
+			name: "protected branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					expectPath(t, "/repos/owner/repo/branches/main/protection").andThen(
+						mockResponse(t, http.StatusOK, mockProtection),
+					),
+				),
+			),
+			expectedResult: MinimalBranchProtection{
+				Branch:        "main",
+				Protected:     true,
+				EnforceAdmins: true,
+				RequiredStatusChecks: &MinimalRequiredStatusChecks{
+					Strict: true,
+					Checks: []string{"build", "test"},
+				},
+				RequiredPullRequestReviews: &MinimalRequiredReviews{
+					RequiredApprovingReviewCount: 2,
+					RequireCodeOwnerReviews:      true,
+					BypassAllowances:             []string{"user:octocat", "team:maintainers"},
+				},
+				PushRestrictedTo: []string{"app:release-bot"},
+			},
+		},
+		{
+			name: "unprotected branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Branch not protected"}),
+				),
+			),
+			expectedResult: MinimalBranchProtection{Branch: "main"},
+		},
+		{
+			name: "branch not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Branch not found"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get branch protection",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetBranchProtection(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned MinimalBranchProtection
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_GetBranchRules(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetBranchRules(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_branch_rules", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	mockRules := []map[string]any{
+		{
+			"type":                "required_status_checks",
+			"ruleset_source_type": "Repository",
+			"ruleset_source":      "owner/repo",
+			"ruleset_id":          42,
+			"parameters": map[string]any{
+				"required_status_checks":               []map[string]any{{"context": "ci"}},
+				"strict_required_status_checks_policy": true,
+			},
+		},
+		{
+			"type":                "non_fast_forward",
+			"ruleset_source_type": "Repository",
+			"ruleset_source":      "owner/repo",
+			"ruleset_id":          42,
+		},
+		{
+			"type":                "deletion",
+			"ruleset_source_type": "Organization",
+			"ruleset_source":      "owner",
+			"ruleset_id":          7,
+		},
+	}
+
+	mockRuleset := &github.RepositoryRuleset{
+		ID:          github.Ptr(int64(42)),
+		Name:        "main protection",
+		Target:      github.Ptr(github.RulesetTargetBranch),
+		SourceType:  github.Ptr(github.RulesetSourceTypeRepository),
+		Source:      "owner/repo",
+		Enforcement: github.RulesetEnforcementActive,
+		BypassActors: []*github.BypassActor{{
+			ActorID:    github.Ptr(int64(5)),
+			ActorType:  github.Ptr(github.BypassActorTypeRepositoryRole),
+			BypassMode: github.Ptr(github.BypassModeAlways),
+		}},
+		CurrentUserCanBypass: github.Ptr(github.BypassModeNever),
+	}
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposRulesBranchesByOwnerByRepoByBranch,
+			expectPath(t, "/repos/owner/repo/rules/branches/main").andThen(
+				mockResponse(t, http.StatusOK, mockRules),
+			),
+		),
+		mock.WithRequestMatchHandler(
+			mock.GetReposRulesetsByOwnerByRepoByRulesetId,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/repos/owner/repo/rulesets/42" {
+					mockResponse(t, http.StatusOK, mockRuleset)(w, r)
+					return
+				}
+				mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"})(w, r)
+			}),
+		),
+	)
+
+	client := github.NewClient(mockedClient)
+	_, handler := GetBranchRules(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	request := createMCPRequest(map[string]interface{}{
+		"owner":  "owner",
+		"repo":   "repo",
+		"branch": "main",
+	})
+	result, err := handler(context.Background(), request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	textContent := getTextResult(t, result)
+	var returned BranchRulesResult
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+
+	assert.Equal(t, "main", returned.Branch)
+	require.Len(t, returned.Rules, 3)
+	assert.Equal(t, "required_status_checks", returned.Rules[0].Type)
+	assert.JSONEq(t, `{"required_status_checks":[{"context":"ci"}],"strict_required_status_checks_policy":true}`, string(returned.Rules[0].Parameters))
+	assert.Empty(t, returned.Rules[2].Parameters)
+
+	require.Len(t, returned.Rulesets, 2)
+	assert.Equal(t, int64(42), returned.Rulesets[0].ID)
+	assert.Equal(t, "main protection", returned.Rulesets[0].Name)
+	assert.Equal(t, "active", returned.Rulesets[0].Enforcement)
+	require.Len(t, returned.Rulesets[0].BypassActors, 1)
+	assert.Equal(t, int64(5), returned.Rulesets[0].BypassActors[0].GetActorID())
+	assert.Equal(t, "never", returned.Rulesets[0].CurrentUserCanBypass)
+	assert.Equal(t, int64(7), returned.Rulesets[1].ID)
+	assert.Equal(t, "owner", returned.Rulesets[1].Source)
+	require.Len(t, returned.Notes, 1)
+	assert.Contains(t, returned.Notes[0], "ruleset 7")
+}
+
+func Test_GetBranchRules_EscapesBranch(t *testing.T) {
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposRulesBranchesByOwnerByRepoByBranch,
+			expect(t, expectations{
+				path:        "/repos/owner/repo/rules/branches/fix#42",
+				queryParams: map[string]string{"per_page": "100"},
+			}).andThen(
+				mockResponse(t, http.StatusOK, []map[string]any{}),
+			),
+		),
+	))
+	_, handler := GetBranchRules(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":  "owner",
+		"repo":   "repo",
+		"branch": "fix#42",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	textContent := getTextResult(t, result)
+	var returned BranchRulesResult
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+	assert.Equal(t, "fix#42", returned.Branch)
+	assert.Empty(t, returned.Rules)
+}
+
+func Test_ListRepositoryRulesets(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRepositoryRulesets(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_repository_rulesets", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "includes_parents")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockRulesets := []*github.RepositoryRuleset{
+		{
+			ID:          github.Ptr(int64(42)),
+			Name:        "main protection",
+			Target:      github.Ptr(github.RulesetTargetBranch),
+			SourceType:  github.Ptr(github.RulesetSourceTypeRepository),
+			Source:      "owner/repo",
+			Enforcement: github.RulesetEnforcementActive,
+		},
+	}
+
+	tests := []struct {
+		name                string
+		args                map[string]interface{}
+		expectedQueryParams map[string]string
+	}{
+		{
+			name: "includes parents by default",
+			args: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedQueryParams: map[string]string{"includes_parents": "true", "page": "1", "per_page": "30"},
+		},
+		{
+			name: "repository rulesets only",
+			args: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"includes_parents": false,
+				"page":             float64(2),
+				"perPage":          float64(10),
+			},
+			expectedQueryParams: map[string]string{"includes_parents": "false", "page": "2", "per_page": "10"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesetsByOwnerByRepo,
+					expectQueryParams(t, tc.expectedQueryParams).andThen(
+						mockResponse(t, http.StatusOK, mockRulesets),
+					),
+				),
+			))
+			_, handler := ListRepositoryRulesets(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			var returned []MinimalRuleset
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			require.Len(t, returned, 1)
+			assert.Equal(t, MinimalRuleset{
+				ID:          42,
+				Name:        "main protection",
+				Target:      "branch",
+				Source:      "owner/repo",
+				SourceType:  "Repository",
+				Enforcement: "active",
+			}, returned[0])
+		})
+	}
+}
+
+func Test_GetRepositoryRuleset(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositoryRuleset(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_repository_ruleset", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ruleset_id"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposRulesetsByOwnerByRepoByRulesetId,
+			expect(t, expectations{
+				path:        "/repos/owner/repo/rulesets/42",
+				queryParams: map[string]string{"includes_parents": "true"},
+			}).andThen(
+				mockResponse(t, http.StatusOK, map[string]any{
+					"id":          42,
+					"name":        "main protection",
+					"enforcement": "active",
+					"rules":       []map[string]any{{"type": "deletion"}},
+				}),
+			),
+		),
+	))
+	_, handler := GetRepositoryRuleset(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":      "owner",
+		"repo":       "repo",
+		"ruleset_id": float64(42),
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	var returned github.RepositoryRuleset
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+	assert.Equal(t, "main protection", returned.Name)
+	require.NotNil(t, returned.Rules)
+	assert.NotNil(t, returned.Rules.Deletion)
+}
+
+func Test_CreateRepositoryRuleset(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateRepositoryRuleset(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_repository_ruleset", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.InputSchema.Properties, "rules")
+	assert.Contains(t, tool.InputSchema.Properties, "bypass_actors")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "name", "enforcement"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "create ruleset",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposRulesetsByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"name":        "main protection",
+						"target":      "branch",
+						"source":      "",
+						"enforcement": "evaluate",
+						"conditions": map[string]any{
+							"ref_name": map[string]any{
+								"include": []any{"~DEFAULT_BRANCH"},
+								"exclude": []any{},
+							},
+						},
+						"rules": []any{map[string]any{"type": "deletion"}},
+						"bypass_actors": []any{map[string]any{
+							"actor_id":    float64(5),
+							"actor_type":  "RepositoryRole",
+							"bypass_mode": "always",
+						}},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, map[string]any{"id": 42, "name": "main protection", "enforcement": "evaluate"}),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"name":         "main protection",
+				"enforcement":  "evaluate",
+				"include_refs": []interface{}{"~DEFAULT_BRANCH"},
+				"rules":        []interface{}{map[string]interface{}{"type": "deletion"}},
+				"bypass_actors": []interface{}{map[string]interface{}{
+					"actor_id":    float64(5),
+					"actor_type":  "RepositoryRole",
+					"bypass_mode": "always",
+				}},
+			},
+		},
+		{
+			name:         "invalid rules",
+			mockedClient: mock.NewMockedHTTPClient(),
+			args: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"name":        "main protection",
+				"enforcement": "active",
+				"rules":       "deletion",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid rules",
+		},
+		{
+			name: "not an admin",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposRulesetsByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"name":        "main protection",
+				"enforcement": "active",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create ruleset",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateRepositoryRuleset(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned github.RepositoryRuleset
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, int64(42), returned.GetID())
+		})
+	}
+}
+
+func Test_UpdateRepositoryRuleset(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateRepositoryRuleset(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_repository_ruleset", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ruleset_id"})
+
+	existingRuleset := map[string]any{
+		"id":          42,
+		"name":        "main protection",
+		"target":      "branch",
+		"source":      "owner/repo",
+		"enforcement": "evaluate",
+		"conditions": map[string]any{
+			"ref_name": map[string]any{"include": []string{"~DEFAULT_BRANCH"}, "exclude": []string{}},
+		},
+		"rules": []map[string]any{{"type": "deletion"}},
+		"bypass_actors": []map[string]any{{
+			"actor_id":    5,
+			"actor_type":  "RepositoryRole",
+			"bypass_mode": "always",
+		}},
+	}
+	getExisting := mock.WithRequestMatchHandler(
+		mock.GetReposRulesetsByOwnerByRepoByRulesetId,
+		mockResponse(t, http.StatusOK, existingRuleset),
+	)
+
+	t.Run("changes only the provided fields", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			getExisting,
+			mock.WithRequestMatchHandler(
+				mock.PutReposRulesetsByOwnerByRepoByRulesetId,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					var body map[string]any
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					assert.Equal(t, "main protection", body["name"])
+					assert.Equal(t, "active", body["enforcement"])
+					assert.Equal(t, []any{map[string]any{"type": "deletion"}}, body["rules"])
+					assert.Len(t, body["bypass_actors"], 1)
+					mockResponse(t, http.StatusOK, body)(w, r)
+				}),
+			),
+		))
+		_, handler := UpdateRepositoryRuleset(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":       "owner",
+			"repo":        "repo",
+			"ruleset_id":  float64(42),
+			"enforcement": "active",
+		}))
+		require.NoError(t, err)
+
+		textContent := getTextResult(t, result)
+		var returned github.RepositoryRuleset
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+		assert.Equal(t, github.RulesetEnforcementActive, returned.Enforcement)
+	})
+
+	t.Run("removes every bypass actor", func(t *testing.T) {
+		puts := 0
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			getExisting,
+			mock.WithRequestMatchHandler(
+				mock.PutReposRulesetsByOwnerByRepoByRulesetId,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					puts++
+					var body map[string]any
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					if puts == 1 {
+						assert.Equal(t, map[string]any{"bypass_actors": nil}, body)
+					} else {
+						assert.NotContains(t, body, "bypass_actors")
+					}
+					mockResponse(t, http.StatusOK, body)(w, r)
+				}),
+			),
+		))
+		_, handler := UpdateRepositoryRuleset(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":         "owner",
+			"repo":          "repo",
+			"ruleset_id":    float64(42),
+			"bypass_actors": []interface{}{},
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		assert.Equal(t, 2, puts)
+	})
+
+	t.Run("no update parameters", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(getExisting))
+		_, handler := UpdateRepositoryRuleset(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":      "owner",
+			"repo":       "repo",
+			"ruleset_id": float64(42),
+		}))
+		require.NoError(t, err)
+
+		errorContent := getErrorResult(t, result)
+		assert.Contains(t, errorContent.Text, "No update parameters provided.")
+	})
+}
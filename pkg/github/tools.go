@@ -25,16 +25,29 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 		AddReadTools(
 			toolsets.NewServerTool(SearchRepositories(getClient, t)),
 			toolsets.NewServerTool(GetFileContents(getClient, getRawClient, t)),
+			toolsets.NewServerTool(GetFileBlame(getGQLClient, t)),
 			toolsets.NewServerTool(ListCommits(getClient, t)),
 			toolsets.NewServerTool(SearchCode(getClient, t)),
 			toolsets.NewServerTool(GetCommit(getClient, t)),
+			toolsets.NewServerTool(CompareRefs(getClient, t, contentWindowSize)),
 			toolsets.NewServerTool(ListBranches(getClient, t)),
 			toolsets.NewServerTool(ListTags(getClient, t)),
 			toolsets.NewServerTool(GetTag(getClient, t)),
+			toolsets.NewServerTool(GetRepositoryTree(getClient, t)),
+			toolsets.NewServerTool(GetBlob(getClient, t)),
+			toolsets.NewServerTool(ListRefs(getClient, t)),
 			toolsets.NewServerTool(ListReleases(getClient, t)),
 			toolsets.NewServerTool(GetLatestRelease(getClient, t)),
 			toolsets.NewServerTool(GetReleaseByTag(getClient, t)),
 			toolsets.NewServerTool(ListStarredRepositories(getClient, t)),
+			toolsets.NewServerTool(GetBranchProtection(getClient, t)),
+			toolsets.NewServerTool(GetBranchRules(getClient, t)),
+			toolsets.NewServerTool(ListRepositoryRulesets(getClient, t)),
+			toolsets.NewServerTool(GetRepositoryRuleset(getClient, t)),
+			toolsets.NewServerTool(ListRepositoryCollaborators(getClient, t)),
+			toolsets.NewServerTool(ListRepositoryTeams(getClient, t)),
+			toolsets.NewServerTool(GetCollaboratorPermission(getClient, t)),
+			toolsets.NewServerTool(GetRepository(getClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(CreateOrUpdateFile(getClient, t)),
@@ -43,6 +56,15 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(CreateBranch(getClient, t)),
 			toolsets.NewServerTool(PushFiles(getClient, t)),
 			toolsets.NewServerTool(DeleteFile(getClient, t)),
+			toolsets.NewServerTool(UpdateRef(getClient, t)),
+			toolsets.NewServerTool(DeleteRef(getClient, t)),
+			toolsets.NewServerTool(CreateTag(getClient, t)),
+			toolsets.NewServerTool(CreateRelease(getClient, t)),
+			toolsets.NewServerTool(UpdateRelease(getClient, t)),
+			toolsets.NewServerTool(GenerateReleaseNotes(getClient, t)),
+			toolsets.NewServerTool(DeleteRelease(getClient, t)),
+			toolsets.NewServerTool(UploadReleaseAsset(getClient, t)),
+			toolsets.NewServerTool(DeleteReleaseAsset(getClient, t)),
 			toolsets.NewServerTool(StarRepository(getClient, t)),
 			toolsets.NewServerTool(UnstarRepository(getClient, t)),
 		).
@@ -53,12 +75,25 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerResourceTemplate(GetRepositoryResourceTagContent(getClient, getRawClient, t)),
 			toolsets.NewServerResourceTemplate(GetRepositoryResourcePrContent(getClient, getRawClient, t)),
 		)
+	// Repository administration changes the settings, rules and access that everyone working in a repository relies on.
+	repoAdmin := toolsets.NewToolset("repo_admin", "GitHub repository administration tools, such as changing repository settings and managing rulesets, collaborators and team access").
+		SetOptIn().
+		AddWriteTools(
+			toolsets.NewServerTool(UpdateRepository(getClient, t)),
+			toolsets.NewServerTool(CreateRepositoryRuleset(getClient, t)),
+			toolsets.NewServerTool(UpdateRepositoryRuleset(getClient, t)),
+			toolsets.NewServerTool(AddRepositoryCollaborator(getClient, t)),
+			toolsets.NewServerTool(RemoveRepositoryCollaborator(getClient, t)),
+			toolsets.NewServerTool(SetTeamRepositoryPermission(getClient, t)),
+		)
 	issues := toolsets.NewToolset("issues", "GitHub Issues related tools").
 		AddReadTools(
 			toolsets.NewServerTool(GetIssue(getClient, t)),
 			toolsets.NewServerTool(SearchIssues(getClient, t)),
 			toolsets.NewServerTool(ListIssues(getGQLClient, t)),
 			toolsets.NewServerTool(GetIssueComments(getClient, t)),
+			toolsets.NewServerTool(GetIssueTimeline(getClient, t)),
+			toolsets.NewServerTool(GetLinkedPullRequests(getGQLClient, t)),
 			toolsets.NewServerTool(ListIssueTypes(getClient, t)),
 			toolsets.NewServerTool(ListSubIssues(getClient, t)),
 		).
@@ -74,6 +109,34 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 		toolsets.NewServerPrompt(AssignCodingAgentPrompt(t)),
 		toolsets.NewServerPrompt(IssueToFixWorkflowPrompt(t)),
 	)
+	labels := toolsets.NewToolset("labels", "GitHub label related tools: repository labels and the labels of issues and pull requests").
+		AddReadTools(
+			toolsets.NewServerTool(ListLabels(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(CreateLabel(getClient, t)),
+			toolsets.NewServerTool(UpdateLabel(getClient, t)),
+			toolsets.NewServerTool(DeleteLabel(getClient, t)),
+			toolsets.NewServerTool(AddLabelsToIssue(getClient, t)),
+			toolsets.NewServerTool(RemoveLabelsFromIssue(getClient, t)),
+		)
+	milestones := toolsets.NewToolset("milestones", "GitHub milestone related tools").
+		AddReadTools(
+			toolsets.NewServerTool(ListMilestones(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(CreateMilestone(getClient, t)),
+			toolsets.NewServerTool(UpdateMilestone(getClient, t)),
+			toolsets.NewServerTool(CloseMilestone(getClient, t)),
+		)
+	reactions := toolsets.NewToolset("reactions", "GitHub reaction related tools: reactions on issues, pull requests, comments and discussions").
+		AddReadTools(
+			toolsets.NewServerTool(ListReactions(getClient, getGQLClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(AddReaction(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(RemoveReaction(getClient, getGQLClient, t)),
+		)
 	users := toolsets.NewToolset("users", "GitHub User related tools").
 		AddReadTools(
 			toolsets.NewServerTool(SearchUsers(getClient, t)),
@@ -81,6 +144,37 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 	orgs := toolsets.NewToolset("orgs", "GitHub Organization related tools").
 		AddReadTools(
 			toolsets.NewServerTool(SearchOrgs(getClient, t)),
+			toolsets.NewServerTool(ListOrgMembers(getClient, t)),
+			toolsets.NewServerTool(ListOrgInvitations(getClient, t)),
+			toolsets.NewServerTool(ListOutsideCollaborators(getClient, t)),
+			toolsets.NewServerTool(GetOrgCustomProperties(getClient, t)),
+			toolsets.NewServerTool(ListOrgCustomPropertyValues(getClient, t)),
+			toolsets.NewServerTool(ListOrgRepositories(getClient, t)),
+		)
+	// Organization administration changes who belongs to an organization and with which role.
+	orgAdmin := toolsets.NewToolset("org_admin", "GitHub organization administration tools, such as inviting and removing members and changing their roles").
+		SetOptIn().
+		AddWriteTools(
+			toolsets.NewServerTool(InviteOrgMember(getClient, t)),
+			toolsets.NewServerTool(RemoveOrgMember(getClient, t)),
+			toolsets.NewServerTool(SetOrgMemberRole(getClient, t)),
+		)
+	webhooks := toolsets.NewToolset("webhooks", "GitHub repository and organization webhook tools, such as listing webhooks and inspecting their deliveries").
+		AddReadTools(
+			toolsets.NewServerTool(ListWebhooks(getClient, t)),
+			toolsets.NewServerTool(GetWebhook(getClient, t)),
+			toolsets.NewServerTool(ListWebhookDeliveries(getClient, t)),
+			toolsets.NewServerTool(GetWebhookDelivery(getClient, t)),
+		)
+	// Changing or deleting a webhook silently breaks the integration receiving its deliveries.
+	webhookAdmin := toolsets.NewToolset("webhook_admin", "GitHub webhook administration tools, such as creating and deleting webhooks and redelivering failed deliveries").
+		SetOptIn().
+		AddWriteTools(
+			toolsets.NewServerTool(CreateWebhook(getClient, t)),
+			toolsets.NewServerTool(UpdateWebhook(getClient, t)),
+			toolsets.NewServerTool(DeleteWebhook(getClient, t)),
+			toolsets.NewServerTool(RedeliverWebhookDelivery(getClient, t)),
+			toolsets.NewServerTool(PingWebhook(getClient, t)),
 		)
 	pullRequests := toolsets.NewToolset("pull_requests", "GitHub Pull Request related tools").
 		AddReadTools(
@@ -89,12 +183,21 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(GetPullRequestFiles(getClient, t)),
 			toolsets.NewServerTool(SearchPullRequests(getClient, t)),
 			toolsets.NewServerTool(GetPullRequestStatus(getClient, t)),
+			toolsets.NewServerTool(GetPullRequestMergeability(getClient, t)),
+			toolsets.NewServerTool(GetPullRequestMergeStatus(getGQLClient, t)),
+			toolsets.NewServerTool(ListPullRequestCommits(getClient, t)),
+			toolsets.NewServerTool(GetPullRequestTimeline(getClient, t)),
 			toolsets.NewServerTool(GetPullRequestReviewComments(getClient, t)),
 			toolsets.NewServerTool(GetPullRequestReviews(getClient, t)),
 			toolsets.NewServerTool(GetPullRequestDiff(getClient, t)),
+			toolsets.NewServerTool(ListPullRequestReviewThreads(getGQLClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(MergePullRequest(getClient, t)),
+			toolsets.NewServerTool(EnablePullRequestAutoMerge(getGQLClient, t)),
+			toolsets.NewServerTool(DisablePullRequestAutoMerge(getGQLClient, t)),
+			toolsets.NewServerTool(AddPullRequestToMergeQueue(getGQLClient, t)),
+			toolsets.NewServerTool(RemovePullRequestFromMergeQueue(getGQLClient, t)),
 			toolsets.NewServerTool(UpdatePullRequestBranch(getClient, t)),
 			toolsets.NewServerTool(CreatePullRequest(getClient, t)),
 			toolsets.NewServerTool(UpdatePullRequest(getClient, getGQLClient, t)),
@@ -106,6 +209,11 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(AddCommentToPendingReview(getGQLClient, t)),
 			toolsets.NewServerTool(SubmitPendingPullRequestReview(getGQLClient, t)),
 			toolsets.NewServerTool(DeletePendingPullRequestReview(getGQLClient, t)),
+
+			// Review threads
+			toolsets.NewServerTool(ReplyToPullRequestReviewThread(getGQLClient, t)),
+			toolsets.NewServerTool(ResolvePullRequestReviewThread(getGQLClient, t)),
+			toolsets.NewServerTool(UnresolvePullRequestReviewThread(getGQLClient, t)),
 		)
 	codeSecurity := toolsets.NewToolset("code_security", "Code security related tools, such as GitHub Code Scanning").
 		AddReadTools(
@@ -141,6 +249,16 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(GetDiscussion(getGQLClient, t)),
 			toolsets.NewServerTool(GetDiscussionComments(getGQLClient, t)),
 			toolsets.NewServerTool(ListDiscussionCategories(getGQLClient, t)),
+			toolsets.NewServerTool(SearchDiscussions(getGQLClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(CreateDiscussion(getGQLClient, t)),
+			toolsets.NewServerTool(AddDiscussionComment(getGQLClient, t)),
+			toolsets.NewServerTool(MarkDiscussionCommentAsAnswer(getGQLClient, t)),
+			toolsets.NewServerTool(UpdateDiscussion(getGQLClient, t)),
+			toolsets.NewServerTool(CloseDiscussion(getGQLClient, t)),
+			toolsets.NewServerTool(ReopenDiscussion(getGQLClient, t)),
+			toolsets.NewServerTool(LockDiscussion(getGQLClient, t)),
 		)
 
 	actions := toolsets.NewToolset("actions", "GitHub Actions workflows and CI/CD operations").
@@ -163,6 +281,20 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(DeleteWorkflowRunLogs(getClient, t)),
 		)
 
+	checks := toolsets.NewToolset("checks", "GitHub Checks API: check runs, check suites and annotations reported by CI").
+		AddReadTools(
+			toolsets.NewServerTool(ListCheckRuns(getClient, t)),
+			toolsets.NewServerTool(ListCheckSuites(getClient, t)),
+			toolsets.NewServerTool(GetCheckRun(getClient, t)),
+			toolsets.NewServerTool(ListCheckRunAnnotations(getClient, t)),
+			toolsets.NewServerTool(GetPullRequestCheckSummary(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(CreateCheckRun(getClient, t)),
+			toolsets.NewServerTool(UpdateCheckRun(getClient, t)),
+			toolsets.NewServerTool(RerequestCheckSuite(getClient, t)),
+		)
+
 	securityAdvisories := toolsets.NewToolset("security_advisories", "Security advisories related tools").
 		AddReadTools(
 			toolsets.NewServerTool(ListGlobalSecurityAdvisories(getClient, t)),
@@ -208,11 +340,19 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 	// Add toolsets to the group
 	tsg.AddToolset(contextTools)
 	tsg.AddToolset(repos)
+	tsg.AddToolset(repoAdmin)
 	tsg.AddToolset(issues)
+	tsg.AddToolset(labels)
+	tsg.AddToolset(milestones)
+	tsg.AddToolset(reactions)
 	tsg.AddToolset(orgs)
+	tsg.AddToolset(orgAdmin)
+	tsg.AddToolset(webhooks)
+	tsg.AddToolset(webhookAdmin)
 	tsg.AddToolset(users)
 	tsg.AddToolset(pullRequests)
 	tsg.AddToolset(actions)
+	tsg.AddToolset(checks)
 	tsg.AddToolset(codeSecurity)
 	tsg.AddToolset(secretProtection)
 	tsg.AddToolset(dependabot)
@@ -0,0 +1,627 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-http/pkg/errors"
+	"github.com/github/github-mcp-http/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// WebhookDeliveries is the output type of list_webhook_deliveries.
+type WebhookDeliveries struct {
+	Deliveries []MinimalHookDelivery `json:"deliveries"`
+	// NextCursor is empty on the last page.
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// WebhookDeliveryMessage is the request sent or the response received for a webhook delivery.
+type WebhookDeliveryMessage struct {
+	Headers map[string]string `json:"headers"`
+	Payload *json.RawMessage  `json:"payload,omitempty"`
+}
+
+// WebhookDelivery is the output type of get_webhook_delivery.
+type WebhookDelivery struct {
+	MinimalHookDelivery
+	Request  *WebhookDeliveryMessage `json:"request,omitempty"`
+	Response *WebhookDeliveryMessage `json:"response,omitempty"`
+}
+
+// withWebhookScope adds the owner and repo parameters that select between repository and organization webhooks.
+func withWebhookScope() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner, or the organization when repo is not provided"),
+		)(tool)
+		mcp.WithString("repo",
+			mcp.Description("Repository name. If not provided, the webhooks of the owner organization are used"),
+		)(tool)
+	}
+}
+
+// webhookScope reads the parameters added by withWebhookScope. repo is empty for organization webhooks.
+func webhookScope(request mcp.CallToolRequest) (owner, repo string, err error) {
+	owner, err = RequiredParam[string](request, "owner")
+	if err != nil {
+		return "", "", err
+	}
+	repo, err = OptionalParam[string](request, "repo")
+	if err != nil {
+		return "", "", err
+	}
+	return owner, repo, nil
+}
+
+// withWebhookFields adds the parameters shared by create_webhook and update_webhook, other than url.
+func withWebhookFields() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("content_type",
+			mcp.Description("Media type used to serialize the payloads"),
+			mcp.Enum("json", "form"),
+		)(tool)
+		mcp.WithString("secret",
+			mcp.Description("Secret used to sign the payloads with the X-Hub-Signature-256 header"),
+		)(tool)
+		mcp.WithBoolean("insecure_ssl",
+			mcp.Description("Skip verification of the SSL certificate of the url. Not recommended"),
+		)(tool)
+		mcp.WithArray("events",
+			mcp.Description("Events that trigger the webhook, e.g. push or pull_request. Use * for all events"),
+			mcp.Items(map[string]any{
+				"type": "string",
+			}),
+		)(tool)
+		mcp.WithBoolean("active",
+			mcp.Description("Whether deliveries are sent when the webhook is triggered"),
+		)(tool)
+	}
+}
+
+// webhookFields reads url and the parameters added by withWebhookFields. The config of the
+// returned hook is nil when none of the configuration parameters were given.
+func webhookFields(request mcp.CallToolRequest) (*github.Hook, error) {
+	hook := &github.Hook{}
+	config := &github.HookConfig{}
+	configChanged := false
+	for name, field := range map[string]**string{
+		"url":          &config.URL,
+		"content_type": &config.ContentType,
+		"secret":       &config.Secret,
+	} {
+		value, ok, err := OptionalParamOK[string](request, name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			*field = github.Ptr(value)
+			configChanged = true
+		}
+	}
+	insecureSSL, ok, err := OptionalParamOK[bool](request, "insecure_ssl")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		config.InsecureSSL = github.Ptr("0")
+		if insecureSSL {
+			config.InsecureSSL = github.Ptr("1")
+		}
+		configChanged = true
+	}
+	if configChanged {
+		hook.Config = config
+	}
+
+	events, err := OptionalStringArrayParam(request, "events")
+	if err != nil {
+		return nil, err
+	}
+	if len(events) > 0 {
+		hook.Events = events
+	}
+	active, ok, err := OptionalParamOK[bool](request, "active")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		hook.Active = github.Ptr(active)
+	}
+	return hook, nil
+}
+
+// ListWebhooks creates a tool to list the webhooks of a repository or organization.
+func ListWebhooks(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_webhooks",
+			mcp.WithDescription(t("TOOL_LIST_WEBHOOKS_DESCRIPTION", "List the webhooks of a GitHub repository, or of an organization when repo is not provided, with the status of their last delivery.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_WEBHOOKS_USER_TITLE", "List webhooks"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			withWebhookScope(),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, err := webhookScope(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			}
+			var hooks []*github.Hook
+			var resp *github.Response
+			if repo != "" {
+				hooks, resp, err = client.Repositories.ListHooks(ctx, owner, repo, opts)
+			} else {
+				hooks, resp, err = client.Organizations.ListHooks(ctx, owner, opts)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list webhooks", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]MinimalWebhook, 0, len(hooks))
+			for _, hook := range hooks {
+				result = append(result, convertToMinimalWebhook(hook))
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// GetWebhook creates a tool to get a webhook of a repository or organization.
+func GetWebhook(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_webhook",
+			mcp.WithDescription(t("TOOL_GET_WEBHOOK_DESCRIPTION", "Get a webhook of a GitHub repository, or of an organization when repo is not provided.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_WEBHOOK_USER_TITLE", "Get webhook"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			withWebhookScope(),
+			mcp.WithNumber("hook_id",
+				mcp.Required(),
+				mcp.Description("Webhook ID"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, err := webhookScope(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hookID, err := RequiredInt(request, "hook_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var hook *github.Hook
+			var resp *github.Response
+			if repo != "" {
+				hook, resp, err = client.Repositories.GetHook(ctx, owner, repo, int64(hookID))
+			} else {
+				hook, resp, err = client.Organizations.GetHook(ctx, owner, int64(hookID))
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get webhook %d", hookID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(convertToMinimalWebhook(hook)), nil
+		}
+}
+
+// ListWebhookDeliveries creates a tool to list the recent deliveries of a webhook.
+func ListWebhookDeliveries(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_webhook_deliveries",
+			mcp.WithDescription(t("TOOL_LIST_WEBHOOK_DELIVERIES_DESCRIPTION", "List the recent deliveries of a repository or organization webhook, newest first, with their status and HTTP status code. Pass next_cursor as after to get older deliveries, and use get_webhook_delivery to inspect the request and response of a delivery.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_WEBHOOK_DELIVERIES_USER_TITLE", "List webhook deliveries"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			withWebhookScope(),
+			mcp.WithNumber("hook_id",
+				mcp.Required(),
+				mcp.Description("Webhook ID"),
+			),
+			WithCursorPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, err := webhookScope(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hookID, err := RequiredInt(request, "hook_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalCursorPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.ListCursorOptions{
+				PerPage: pagination.PerPage,
+				Cursor:  pagination.After,
+			}
+			var deliveries []*github.HookDelivery
+			var resp *github.Response
+			if repo != "" {
+				deliveries, resp, err = client.Repositories.ListHookDeliveries(ctx, owner, repo, int64(hookID), opts)
+			} else {
+				deliveries, resp, err = client.Organizations.ListHookDeliveries(ctx, owner, int64(hookID), opts)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list deliveries of webhook %d", hookID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := WebhookDeliveries{
+				Deliveries: make([]MinimalHookDelivery, 0, len(deliveries)),
+				NextCursor: resp.Cursor,
+			}
+			for _, delivery := range deliveries {
+				result.Deliveries = append(result.Deliveries, convertToMinimalHookDelivery(delivery))
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// GetWebhookDelivery creates a tool to get a webhook delivery with its request and response.
+func GetWebhookDelivery(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_webhook_delivery",
+			mcp.WithDescription(t("TOOL_GET_WEBHOOK_DELIVERY_DESCRIPTION", "Get a delivery of a repository or organization webhook, including the headers and payload of the request GitHub sent and of the response it received.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_WEBHOOK_DELIVERY_USER_TITLE", "Get webhook delivery"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			withWebhookScope(),
+			mcp.WithNumber("hook_id",
+				mcp.Required(),
+				mcp.Description("Webhook ID"),
+			),
+			mcp.WithNumber("delivery_id",
+				mcp.Required(),
+				mcp.Description("Delivery ID, as listed by list_webhook_deliveries"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, err := webhookScope(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hookID, err := RequiredInt(request, "hook_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			deliveryID, err := RequiredInt(request, "delivery_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var delivery *github.HookDelivery
+			var resp *github.Response
+			if repo != "" {
+				delivery, resp, err = client.Repositories.GetHookDelivery(ctx, owner, repo, int64(hookID), int64(deliveryID))
+			} else {
+				delivery, resp, err = client.Organizations.GetHookDelivery(ctx, owner, int64(hookID), int64(deliveryID))
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get delivery %d of webhook %d", deliveryID, hookID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := WebhookDelivery{
+				MinimalHookDelivery: convertToMinimalHookDelivery(delivery),
+			}
+			if delivery.Request != nil {
+				result.Request = &WebhookDeliveryMessage{
+					Headers: delivery.Request.Headers,
+					Payload: delivery.Request.RawPayload,
+				}
+			}
+			if delivery.Response != nil {
+				result.Response = &WebhookDeliveryMessage{
+					Headers: delivery.Response.Headers,
+					Payload: delivery.Response.RawPayload,
+				}
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// CreateWebhook creates a tool to create a webhook for a repository or organization.
+func CreateWebhook(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_webhook",
+			mcp.WithDescription(t("TOOL_CREATE_WEBHOOK_DESCRIPTION", "Create a webhook for a GitHub repository, or for an organization when repo is not provided. GitHub sends a ping event to the new webhook.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_WEBHOOK_USER_TITLE", "Create webhook"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			withWebhookScope(),
+			mcp.WithString("url",
+				mcp.Required(),
+				mcp.Description("URL the payloads are delivered to"),
+			),
+			withWebhookFields(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, err := webhookScope(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if _, err := RequiredParam[string](request, "url"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hook, err := webhookFields(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var created *github.Hook
+			var resp *github.Response
+			if repo != "" {
+				created, resp, err = client.Repositories.CreateHook(ctx, owner, repo, hook)
+			} else {
+				created, resp, err = client.Organizations.CreateHook(ctx, owner, hook)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create webhook", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(convertToMinimalWebhook(created)), nil
+		}
+}
+
+// UpdateWebhook creates a tool to update a webhook of a repository or organization.
+func UpdateWebhook(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_webhook",
+			mcp.WithDescription(t("TOOL_UPDATE_WEBHOOK_DESCRIPTION", "Update a webhook of a GitHub repository, or of an organization when repo is not provided. Only the given fields are changed, and events replaces the list of events.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_WEBHOOK_USER_TITLE", "Update webhook"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			withWebhookScope(),
+			mcp.WithNumber("hook_id",
+				mcp.Required(),
+				mcp.Description("Webhook ID"),
+			),
+			mcp.WithString("url",
+				mcp.Description("URL the payloads are delivered to"),
+			),
+			withWebhookFields(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, err := webhookScope(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hookID, err := RequiredInt(request, "hook_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hook, err := webhookFields(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if hook.Config == nil && hook.Events == nil && hook.Active == nil {
+				return mcp.NewToolResultError("No update parameters provided."), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// The configuration is updated through its own endpoint, which keeps the
+			// fields that are not given, such as the secret.
+			if hook.Config != nil {
+				var resp *github.Response
+				if repo != "" {
+					_, resp, err = client.Repositories.EditHookConfiguration(ctx, owner, repo, int64(hookID), hook.Config)
+				} else {
+					_, resp, err = client.Organizations.EditHookConfiguration(ctx, owner, int64(hookID), hook.Config)
+				}
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to update configuration of webhook %d", hookID), resp, err), nil
+				}
+				_ = resp.Body.Close()
+				hook.Config = nil
+			}
+
+			var updated *github.Hook
+			var resp *github.Response
+			if repo != "" {
+				updated, resp, err = client.Repositories.EditHook(ctx, owner, repo, int64(hookID), hook)
+			} else {
+				updated, resp, err = client.Organizations.EditHook(ctx, owner, int64(hookID), hook)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to update webhook %d", hookID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(convertToMinimalWebhook(updated)), nil
+		}
+}
+
+// DeleteWebhook creates a tool to delete a webhook of a repository or organization.
+func DeleteWebhook(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_webhook",
+			mcp.WithDescription(t("TOOL_DELETE_WEBHOOK_DESCRIPTION", "Delete a webhook of a GitHub repository, or of an organization when repo is not provided.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_DELETE_WEBHOOK_USER_TITLE", "Delete webhook"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			withWebhookScope(),
+			mcp.WithNumber("hook_id",
+				mcp.Required(),
+				mcp.Description("Webhook ID"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, err := webhookScope(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hookID, err := RequiredInt(request, "hook_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var resp *github.Response
+			if repo != "" {
+				resp, err = client.Repositories.DeleteHook(ctx, owner, repo, int64(hookID))
+			} else {
+				resp, err = client.Organizations.DeleteHook(ctx, owner, int64(hookID))
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to delete webhook %d", hookID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Deleted webhook %d", hookID)), nil
+		}
+}
+
+// RedeliverWebhookDelivery creates a tool to redeliver a webhook delivery.
+func RedeliverWebhookDelivery(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("redeliver_webhook_delivery",
+			mcp.WithDescription(t("TOOL_REDELIVER_WEBHOOK_DELIVERY_DESCRIPTION", "Send the payload of a delivery of a repository or organization webhook again, e.g. after a failed delivery. The new attempt shows up in list_webhook_deliveries as a redelivery.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_REDELIVER_WEBHOOK_DELIVERY_USER_TITLE", "Redeliver webhook delivery"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			withWebhookScope(),
+			mcp.WithNumber("hook_id",
+				mcp.Required(),
+				mcp.Description("Webhook ID"),
+			),
+			mcp.WithNumber("delivery_id",
+				mcp.Required(),
+				mcp.Description("ID of the delivery to send again"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, err := webhookScope(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hookID, err := RequiredInt(request, "hook_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			deliveryID, err := RequiredInt(request, "delivery_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var resp *github.Response
+			if repo != "" {
+				_, resp, err = client.Repositories.RedeliverHookDelivery(ctx, owner, repo, int64(hookID), int64(deliveryID))
+			} else {
+				_, resp, err = client.Organizations.RedeliverHookDelivery(ctx, owner, int64(hookID), int64(deliveryID))
+			}
+			// GitHub accepts the redelivery and performs it asynchronously.
+			if err != nil && !(resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err)) {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to redeliver delivery %d of webhook %d", deliveryID, hookID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Redelivery of delivery %d of webhook %d requested", deliveryID, hookID)), nil
+		}
+}
+
+// PingWebhook creates a tool to send a ping event to a webhook.
+func PingWebhook(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("ping_webhook",
+			mcp.WithDescription(t("TOOL_PING_WEBHOOK_DESCRIPTION", "Send a ping event to a webhook of a GitHub repository, or of an organization when repo is not provided, to check that its endpoint is reachable. The result shows up in list_webhook_deliveries.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_PING_WEBHOOK_USER_TITLE", "Ping webhook"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			withWebhookScope(),
+			mcp.WithNumber("hook_id",
+				mcp.Required(),
+				mcp.Description("Webhook ID"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, err := webhookScope(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hookID, err := RequiredInt(request, "hook_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var resp *github.Response
+			if repo != "" {
+				resp, err = client.Repositories.PingHook(ctx, owner, repo, int64(hookID))
+			} else {
+				resp, err = client.Organizations.PingHook(ctx, owner, int64(hookID))
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to ping webhook %d", hookID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Ping sent to webhook %d", hookID)), nil
+		}
+}
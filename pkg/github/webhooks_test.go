@@ -0,0 +1,959 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-http/internal/toolsnaps"
+	"github.com/github/github-mcp-http/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListWebhooks(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListWebhooks(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_webhooks", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner"})
+
+	mockHooks := []*github.Hook{
+		{
+			ID:     github.Ptr(int64(1)),
+			Name:   github.Ptr("web"),
+			Events: []string{"push", "pull_request"},
+			Active: github.Ptr(true),
+			Config: &github.HookConfig{
+				URL:         github.Ptr("https://example.com/hook"),
+				ContentType: github.Ptr("json"),
+				InsecureSSL: github.Ptr("0"),
+				Secret:      github.Ptr("********"),
+			},
+			CreatedAt: &github.Timestamp{Time: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)},
+			LastResponse: map[string]any{
+				"code":    502,
+				"status":  "failed",
+				"message": "Invalid HTTP Response: 502",
+			},
+		},
+	}
+	expectedHooks := []MinimalWebhook{
+		{
+			ID:          1,
+			Name:        "web",
+			URL:         "https://example.com/hook",
+			ContentType: "json",
+			HasSecret:   true,
+			Events:      []string{"push", "pull_request"},
+			Active:      true,
+			CreatedAt:   "2024-05-01T10:00:00Z",
+			LastResponse: &MinimalWebhookResponse{
+				Code:    502,
+				Status:  "failed",
+				Message: "Invalid HTTP Response: 502",
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectError    bool
+		expectedResult []MinimalWebhook
+		expectedErrMsg string
+	}{
+		{
+			name: "repository webhooks",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposHooksByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockHooks),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectedResult: expectedHooks,
+		},
+		{
+			name: "organization webhooks",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetOrgsHooksByOrg,
+					mockHooks,
+				),
+			),
+			args: map[string]interface{}{
+				"owner": "org",
+			},
+			expectedResult: expectedHooks,
+		},
+		{
+			name: "no access to webhooks",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsHooksByOrg,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			args: map[string]interface{}{
+				"owner": "org",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list webhooks",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListWebhooks(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			assert.NotContains(t, textContent.Text, "********")
+			var returned []MinimalWebhook
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_GetWebhook(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetWebhook(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_webhook", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "hook_id"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectError    bool
+		expectedResult MinimalWebhook
+		expectedErrMsg string
+	}{
+		{
+			name: "repository webhook",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposHooksByOwnerByRepoByHookId,
+					expectPath(t, "/repos/owner/repo/hooks/12").andThen(
+						mockResponse(t, http.StatusOK, &github.Hook{
+							ID:     github.Ptr(int64(12)),
+							Name:   github.Ptr("web"),
+							Events: []string{"*"},
+							Active: github.Ptr(false),
+							Config: &github.HookConfig{
+								URL:         github.Ptr("http://example.com/hook"),
+								ContentType: github.Ptr("form"),
+								InsecureSSL: github.Ptr("1"),
+							},
+						}),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"hook_id": float64(12),
+			},
+			expectedResult: MinimalWebhook{
+				ID:          12,
+				Name:        "web",
+				URL:         "http://example.com/hook",
+				ContentType: "form",
+				InsecureSSL: true,
+				Events:      []string{"*"},
+			},
+		},
+		{
+			name: "webhook not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsHooksByOrgByHookId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":   "org",
+				"hook_id": float64(12),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get webhook 12",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetWebhook(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned MinimalWebhook
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_ListWebhookDeliveries(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListWebhookDeliveries(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_webhook_deliveries", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "after")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "hook_id"})
+
+	mockDeliveries := []*github.HookDelivery{
+		{
+			ID:          github.Ptr(int64(101)),
+			GUID:        github.Ptr("0b989ba4-242f-11e5-81e1-c7b6966d2516"),
+			DeliveredAt: &github.Timestamp{Time: time.Date(2024, 5, 2, 8, 30, 0, 0, time.UTC)},
+			Redelivery:  github.Ptr(false),
+			Duration:    github.Ptr(10.02),
+			Status:      github.Ptr("Invalid HTTP Response: 500"),
+			StatusCode:  github.Ptr(500),
+			Event:       github.Ptr("pull_request"),
+			Action:      github.Ptr("opened"),
+		},
+	}
+	expectedDeliveries := []MinimalHookDelivery{
+		{
+			ID:          101,
+			GUID:        "0b989ba4-242f-11e5-81e1-c7b6966d2516",
+			DeliveredAt: "2024-05-02T08:30:00Z",
+			Duration:    10.02,
+			Status:      "Invalid HTTP Response: 500",
+			StatusCode:  500,
+			Event:       "pull_request",
+			Action:      "opened",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectError    bool
+		expectedResult WebhookDeliveries
+		expectedErrMsg string
+	}{
+		{
+			name: "repository webhook deliveries with next page",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposHooksDeliveriesByOwnerByRepoByHookId,
+					expect(t, expectations{
+						path: "/repos/owner/repo/hooks/12/deliveries",
+						queryParams: map[string]string{
+							"per_page": "1",
+							"cursor":   "v1_100",
+						},
+					}).andThen(
+						func(w http.ResponseWriter, _ *http.Request) {
+							w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/hooks/12/deliveries?per_page=1&cursor=v1_101>; rel="next"`)
+							w.WriteHeader(http.StatusOK)
+							b, _ := json.Marshal(mockDeliveries)
+							_, _ = w.Write(b)
+						},
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"hook_id": float64(12),
+				"perPage": float64(1),
+				"after":   "v1_100",
+			},
+			expectedResult: WebhookDeliveries{
+				Deliveries: expectedDeliveries,
+				NextCursor: "v1_101",
+			},
+		},
+		{
+			name: "organization webhook deliveries",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsHooksDeliveriesByOrgByHookId,
+					expectPath(t, "/orgs/org/hooks/12/deliveries").andThen(
+						mockResponse(t, http.StatusOK, mockDeliveries),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":   "org",
+				"hook_id": float64(12),
+			},
+			expectedResult: WebhookDeliveries{
+				Deliveries: expectedDeliveries,
+			},
+		},
+		{
+			name: "webhook not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsHooksDeliveriesByOrgByHookId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":   "org",
+				"hook_id": float64(12),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list deliveries of webhook 12",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListWebhookDeliveries(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned WebhookDeliveries
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_GetWebhookDelivery(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetWebhookDelivery(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_webhook_delivery", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "hook_id", "delivery_id"})
+
+	requestPayload := json.RawMessage(`{"action":"opened","number":1}`)
+	responsePayload := json.RawMessage(`"upstream connect error"`)
+	mockDelivery := &github.HookDelivery{
+		ID:         github.Ptr(int64(101)),
+		GUID:       github.Ptr("0b989ba4-242f-11e5-81e1-c7b6966d2516"),
+		Status:     github.Ptr("Invalid HTTP Response: 503"),
+		StatusCode: github.Ptr(503),
+		Event:      github.Ptr("pull_request"),
+		Action:     github.Ptr("opened"),
+		Request: &github.HookRequest{
+			Headers:    map[string]string{"X-GitHub-Event": "pull_request"},
+			RawPayload: &requestPayload,
+		},
+		Response: &github.HookResponse{
+			Headers:    map[string]string{"Content-Type": "text/plain"},
+			RawPayload: &responsePayload,
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectError    bool
+		expectedResult WebhookDelivery
+		expectedErrMsg string
+	}{
+		{
+			name: "repository webhook delivery",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposHooksDeliveriesByOwnerByRepoByHookIdByDeliveryId,
+					expectPath(t, "/repos/owner/repo/hooks/12/deliveries/101").andThen(
+						mockResponse(t, http.StatusOK, mockDelivery),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"hook_id":     float64(12),
+				"delivery_id": float64(101),
+			},
+			expectedResult: WebhookDelivery{
+				MinimalHookDelivery: MinimalHookDelivery{
+					ID:         101,
+					GUID:       "0b989ba4-242f-11e5-81e1-c7b6966d2516",
+					Status:     "Invalid HTTP Response: 503",
+					StatusCode: 503,
+					Event:      "pull_request",
+					Action:     "opened",
+				},
+				Request: &WebhookDeliveryMessage{
+					Headers: map[string]string{"X-GitHub-Event": "pull_request"},
+					Payload: &requestPayload,
+				},
+				Response: &WebhookDeliveryMessage{
+					Headers: map[string]string{"Content-Type": "text/plain"},
+					Payload: &responsePayload,
+				},
+			},
+		},
+		{
+			name: "delivery not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsHooksDeliveriesByOrgByHookIdByDeliveryId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":       "org",
+				"hook_id":     float64(12),
+				"delivery_id": float64(101),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get delivery 101 of webhook 12",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetWebhookDelivery(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned WebhookDelivery
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_CreateWebhook(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateWebhook(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_webhook", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "secret")
+	assert.Contains(t, tool.InputSchema.Properties, "events")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "url"})
+
+	mockHook := &github.Hook{
+		ID:     github.Ptr(int64(12)),
+		Name:   github.Ptr("web"),
+		Events: []string{"push"},
+		Active: github.Ptr(true),
+		Config: &github.HookConfig{
+			URL:         github.Ptr("https://example.com/hook"),
+			ContentType: github.Ptr("json"),
+			InsecureSSL: github.Ptr("0"),
+			Secret:      github.Ptr("********"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectError    bool
+		expectedResult MinimalWebhook
+		expectedErrMsg string
+	}{
+		{
+			name: "organization webhook",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsHooksByOrg,
+					expectRequestBody(t, map[string]any{
+						"name":   "web",
+						"events": []any{"push"},
+						"active": true,
+						"config": map[string]any{
+							"url":          "https://example.com/hook",
+							"content_type": "json",
+							"secret":       "s3cret",
+							"insecure_ssl": "0",
+						},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockHook),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":        "org",
+				"url":          "https://example.com/hook",
+				"content_type": "json",
+				"secret":       "s3cret",
+				"insecure_ssl": false,
+				"events":       []any{"push"},
+				"active":       true,
+			},
+			expectedResult: MinimalWebhook{
+				ID:          12,
+				Name:        "web",
+				URL:         "https://example.com/hook",
+				ContentType: "json",
+				HasSecret:   true,
+				Events:      []string{"push"},
+				Active:      true,
+			},
+		},
+		{
+			name: "repository webhook with invalid url",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposHooksByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Validation Failed"}),
+				),
+			),
+			args: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"url":   "not a url",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create webhook",
+		},
+		{
+			name:         "missing url",
+			mockedClient: mock.NewMockedHTTPClient(),
+			args: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: url",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateWebhook(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned MinimalWebhook
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_UpdateWebhook(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateWebhook(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_webhook", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "url")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "hook_id"})
+
+	mockHook := &github.Hook{
+		ID:     github.Ptr(int64(12)),
+		Name:   github.Ptr("web"),
+		Events: []string{"push"},
+		Active: github.Ptr(false),
+		Config: &github.HookConfig{
+			URL: github.Ptr("https://example.com/new"),
+		},
+	}
+	expectedHook := MinimalWebhook{
+		ID:     12,
+		Name:   "web",
+		URL:    "https://example.com/new",
+		Events: []string{"push"},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectError    bool
+		expectedResult MinimalWebhook
+		expectedErrMsg string
+	}{
+		{
+			name: "update configuration and deactivate",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposHooksConfigByOwnerByRepoByHookId,
+					expectRequestBody(t, map[string]any{
+						"url": "https://example.com/new",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockHook.Config),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposHooksByOwnerByRepoByHookId,
+					expectRequestBody(t, map[string]any{
+						"active": false,
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockHook),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"hook_id": float64(12),
+				"url":     "https://example.com/new",
+				"active":  false,
+			},
+			expectedResult: expectedHook,
+		},
+		{
+			name: "replace events of organization webhook",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchOrgsHooksByOrgByHookId,
+					expectRequestBody(t, map[string]any{
+						"events": []any{"push"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockHook),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":   "org",
+				"hook_id": float64(12),
+				"events":  []any{"push"},
+			},
+			expectedResult: expectedHook,
+		},
+		{
+			name: "configuration update fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchOrgsHooksConfigByOrgByHookId,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Validation Failed"}),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":        "org",
+				"hook_id":      float64(12),
+				"content_type": "json",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update configuration of webhook 12",
+		},
+		{
+			name:         "nothing to update",
+			mockedClient: mock.NewMockedHTTPClient(),
+			args: map[string]interface{}{
+				"owner":   "org",
+				"hook_id": float64(12),
+			},
+			expectError:    true,
+			expectedErrMsg: "No update parameters provided.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateWebhook(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned MinimalWebhook
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_DeleteWebhook(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteWebhook(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "delete_webhook", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "hook_id"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "organization webhook",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteOrgsHooksByOrgByHookId,
+					expectPath(t, "/orgs/org/hooks/12").andThen(
+						mockResponse(t, http.StatusNoContent, nil),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":   "org",
+				"hook_id": float64(12),
+			},
+			expectedText: "Deleted webhook 12",
+		},
+		{
+			name: "webhook not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposHooksByOwnerByRepoByHookId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"hook_id": float64(12),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to delete webhook 12",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DeleteWebhook(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_RedeliverWebhookDelivery(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RedeliverWebhookDelivery(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "redeliver_webhook_delivery", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "hook_id", "delivery_id"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "redelivery accepted",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposHooksDeliveriesAttemptsByOwnerByRepoByHookIdByDeliveryId,
+					expectPath(t, "/repos/owner/repo/hooks/12/deliveries/101/attempts").andThen(
+						mockResponse(t, http.StatusAccepted, map[string]any{}),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"hook_id":     float64(12),
+				"delivery_id": float64(101),
+			},
+			expectedText: "Redelivery of delivery 101 of webhook 12 requested",
+		},
+		{
+			name: "delivery not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsHooksDeliveriesAttemptsByOrgByHookIdByDeliveryId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":       "org",
+				"hook_id":     float64(12),
+				"delivery_id": float64(101),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to redeliver delivery 101 of webhook 12",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RedeliverWebhookDelivery(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_PingWebhook(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := PingWebhook(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "ping_webhook", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "hook_id"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		args           map[string]interface{}
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "organization webhook",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsHooksPingsByOrgByHookId,
+					expectPath(t, "/orgs/org/hooks/12/pings").andThen(
+						mockResponse(t, http.StatusNoContent, nil),
+					),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":   "org",
+				"hook_id": float64(12),
+			},
+			expectedText: "Ping sent to webhook 12",
+		},
+		{
+			name: "webhook not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposHooksPingsByOwnerByRepoByHookId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			args: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"hook_id": float64(12),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to ping webhook 12",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := PingWebhook(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+const (
+	// FormatText writes log records as key=value pairs.
+	FormatText = "text"
+	// FormatJSON writes log records as one JSON object per line.
+	FormatJSON = "json"
+)
+
+// NewHandler creates a slog.Handler writing records in the given format ("text" or "json").
+// The level is read from the provided LevelVar on every record, so it can be changed at runtime.
+func NewHandler(w io.Writer, format string, level *slog.LevelVar) (slog.Handler, error) {
+	opts := &slog.HandlerOptions{Level: level}
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "", FormatText:
+		return slog.NewTextHandler(w, opts), nil
+	case FormatJSON:
+		return slog.NewJSONHandler(w, opts), nil
+	default:
+		return nil, fmt.Errorf("unsupported log format %q (expected %q or %q)", format, FormatText, FormatJSON)
+	}
+}
+
+// ParseLevel converts a level name ("debug", "info", "warn" or "error") to a slog.Level.
+func ParseLevel(level string) (slog.Level, error) {
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(strings.TrimSpace(level))); err != nil {
+		return 0, fmt.Errorf("unsupported log level %q (expected debug, info, warn or error)", level)
+	}
+	return l, nil
+}
+
+type loggerKey struct{}
+
+var discardLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// ContextWithLogger returns a copy of ctx carrying the provided logger.
+func ContextWithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext returns the logger stored in ctx. Tool handlers receive a logger that is
+// already annotated with the session ID, MCP request ID and tool name. When ctx carries
+// no logger, a logger that discards all records is returned.
+func FromContext(ctx context.Context) *slog.Logger {
+	if ctx != nil {
+		if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok && logger != nil {
+			return logger
+		}
+	}
+	return discardLogger
+}
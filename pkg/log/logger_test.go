@@ -0,0 +1,72 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHandler(t *testing.T) {
+	t.Run("json format writes one object per record", func(t *testing.T) {
+		var buf bytes.Buffer
+		level := &slog.LevelVar{}
+		handler, err := NewHandler(&buf, FormatJSON, level)
+		require.NoError(t, err)
+
+		slog.New(handler).Info("hello", "tool", "get_me")
+
+		var record map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+		assert.Equal(t, "hello", record["msg"])
+		assert.Equal(t, "get_me", record["tool"])
+	})
+
+	t.Run("level can be changed after creation", func(t *testing.T) {
+		var buf bytes.Buffer
+		level := &slog.LevelVar{}
+		level.Set(slog.LevelWarn)
+		handler, err := NewHandler(&buf, FormatText, level)
+		require.NoError(t, err)
+		logger := slog.New(handler)
+
+		logger.Info("dropped")
+		assert.Empty(t, buf.String())
+
+		level.Set(slog.LevelDebug)
+		logger.Debug("kept")
+		assert.Contains(t, buf.String(), "kept")
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		_, err := NewHandler(&bytes.Buffer{}, "xml", &slog.LevelVar{})
+		assert.ErrorContains(t, err, `unsupported log format "xml"`)
+	})
+}
+
+func TestParseLevel(t *testing.T) {
+	level, err := ParseLevel("warn")
+	require.NoError(t, err)
+	assert.Equal(t, slog.LevelWarn, level)
+
+	level, err = ParseLevel("DEBUG")
+	require.NoError(t, err)
+	assert.Equal(t, slog.LevelDebug, level)
+
+	_, err = ParseLevel("verbose")
+	assert.Error(t, err)
+}
+
+func TestLoggerContext(t *testing.T) {
+	assert.NotNil(t, FromContext(context.Background()))
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	ctx := ContextWithLogger(context.Background(), logger)
+	FromContext(ctx).Info("from context")
+	assert.Contains(t, buf.String(), "from context")
+}
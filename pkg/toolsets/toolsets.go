@@ -52,9 +52,11 @@ type Toolset struct {
 	Name        string
 	Description string
 	Enabled     bool
-	readOnly    bool
-	writeTools  []server.ServerTool
-	readTools   []server.ServerTool
+	// OptIn toolsets are only enabled when requested by name, never by "all".
+	OptIn      bool
+	readOnly   bool
+	writeTools []server.ServerTool
+	readTools  []server.ServerTool
 	// resources are not tools, but the community seems to be moving towards namespaces as a broader concept
 	// and in order to have multiple servers running concurrently, we want to avoid overlapping resources too.
 	resourceTemplates []server.ServerResourceTemplate
@@ -137,6 +139,14 @@ func (t *Toolset) SetReadOnly() {
 	t.readOnly = true
 }
 
+// SetOptIn marks the toolset as opt-in, so that enabling "all" toolsets leaves it disabled.
+// It is meant for toolsets whose tools can change how other people work, such as administration ones,
+// which should only be enabled when requested by name.
+func (t *Toolset) SetOptIn() *Toolset {
+	t.OptIn = true
+	return t
+}
+
 func (t *Toolset) AddWriteTools(tools ...server.ServerTool) *Toolset {
 	// Silently ignore if the toolset is read-only to avoid any breach of that contract
 	for _, tool := range tools {
@@ -191,12 +201,13 @@ func NewToolset(name string, description string) *Toolset {
 }
 
 func (tg *ToolsetGroup) IsEnabled(name string) bool {
-	// If everythingOn is true, all features are enabled
-	if tg.everythingOn {
+	feature, exists := tg.Toolsets[name]
+
+	// If everythingOn is true, all features except opt-in ones are enabled
+	if tg.everythingOn && (!exists || !feature.OptIn) {
 		return true
 	}
 
-	feature, exists := tg.Toolsets[name]
 	if !exists {
 		return false
 	}
@@ -208,7 +219,8 @@ func (tg *ToolsetGroup) EnableToolsets(names []string) error {
 	for _, name := range names {
 		if name == "all" {
 			tg.everythingOn = true
-			break
+			// keep going, as opt-in toolsets can be listed alongside "all"
+			continue
 		}
 		err := tg.EnableToolset(name)
 		if err != nil {
@@ -216,8 +228,12 @@ func (tg *ToolsetGroup) EnableToolsets(names []string) error {
 		}
 	}
 	// Do this after to ensure all toolsets are enabled if "all" is present anywhere in list
+	// Opt-in toolsets stay disabled unless they were requested by name
 	if tg.everythingOn {
-		for name := range tg.Toolsets {
+		for name, toolset := range tg.Toolsets {
+			if toolset.OptIn {
+				continue
+			}
 			err := tg.EnableToolset(name)
 			if err != nil {
 				return err
@@ -208,6 +208,30 @@ func TestEnableEverything(t *testing.T) {
 	}
 }
 
+func TestEnableEverythingSkipsOptInToolsets(t *testing.T) {
+	tsg := NewToolsetGroup(false)
+	tsg.AddToolset(NewToolset("regular", "A regular toolset"))
+	tsg.AddToolset(NewToolset("admin", "An opt-in toolset").SetOptIn())
+
+	if err := tsg.EnableToolsets([]string{"all"}); err != nil {
+		t.Fatalf("Expected no error when enabling 'all', got: %v", err)
+	}
+	if !tsg.IsEnabled("regular") {
+		t.Error("Expected regular toolset to be enabled by 'all'")
+	}
+	if tsg.IsEnabled("admin") {
+		t.Error("Expected opt-in toolset to stay disabled with 'all'")
+	}
+
+	// Opt-in toolsets can still be enabled by name, alongside "all"
+	if err := tsg.EnableToolsets([]string{"all", "admin"}); err != nil {
+		t.Fatalf("Expected no error when enabling 'all' and 'admin', got: %v", err)
+	}
+	if !tsg.IsEnabled("admin") {
+		t.Error("Expected opt-in toolset to be enabled when requested by name")
+	}
+}
+
 func TestIsEnabledWithEverythingOn(t *testing.T) {
 	tsg := NewToolsetGroup(false)
 
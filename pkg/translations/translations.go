@@ -77,3 +77,21 @@ func DumpTranslationKeyMap(translationKeyMap map[string]string) error {
 
 	return nil
 }
+
+// WithOverrides returns a TranslationHelperFunc that resolves keys from overrides
+// before falling back to the provided helper.
+func WithOverrides(t TranslationHelperFunc, overrides map[string]string) TranslationHelperFunc {
+	if len(overrides) == 0 {
+		return t
+	}
+	normalized := make(map[string]string, len(overrides))
+	for key, value := range overrides {
+		normalized[strings.ToUpper(key)] = value
+	}
+	return func(key string, defaultValue string) string {
+		if value, exists := normalized[strings.ToUpper(key)]; exists {
+			return value
+		}
+		return t(key, defaultValue)
+	}
+}